@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/activity"
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/spf13/cobra"
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity [<revision-range>...] [-- <path>...]",
+	Short: "Show commit activity as a heatmap and time series",
+	Long: `Bucket commits by day, week or month and by weekday and hour of day.
+
+The activity command renders:
+- A GitHub-style calendar heatmap of commits per day
+- A sparkline and bar chart of commits per day, week or month
+- Commits by weekday and a weekday × hour punchcard
+
+It accepts the same filters as glo log, including --where. Times are bucketed in each
+commit's own time zone, so weekend and late-night work stand out.
+
+Output formats:
+- color (default): Heatmap and charts drawn with Unicode blocks
+- json: All buckets as JSON
+- csv: The buckets selected with --by as CSV
+
+Examples:
+  glo activity                               # Heatmap and weekly chart
+  glo activity --by=month                    # Commits per month
+  glo activity --author="John Doe"           # Activity of one author
+  glo activity --since="2024-01-01"          # Activity since date
+  glo activity --format=json                 # Export buckets as JSON
+  glo activity --by=weekday-hour --format=csv # Punchcard as CSV`,
+	Run: runActivityCommand,
+}
+
+func runActivityCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	period, _ := cmd.Flags().GetString("by")
+	format, _ := cmd.Flags().GetString("format")
+
+	period = strings.ToLower(period)
+	if !activity.ValidPeriod(period) {
+		fmt.Fprintf(os.Stderr, "Error: Unknown period '%s'. Use: day, week, month, or weekday-hour\n", period)
+		os.Exit(1)
+	}
+
+	filters, err := readCommitFilters(cmd, args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	commits, err := filters.FetchCommits(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching git logs: %v\n", err)
+		os.Exit(1)
+	}
+
+	report, err := activity.Build(commits, period)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	activityFormatter := formatters.NewActivityFormatter(format == "color")
+
+	var output string
+	switch strings.ToLower(format) {
+	case "json":
+		output, err = activityFormatter.FormatJSON(report)
+		output += "\n"
+	case "csv":
+		output, err = activityFormatter.FormatCSV(report)
+	case "color", "":
+		output = activityFormatter.FormatColor(report)
+	default:
+		err = fmt.Errorf("unknown format '%s'. Use: color, json, or csv", format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+}
+
+func init() {
+	rootCmd.AddCommand(activityCmd)
+
+	addCommitFilterFlags(activityCmd)
+	activityCmd.Flags().StringP("by", "b", "week", "Bucket size: day, week, month, weekday-hour")
+	activityCmd.Flags().StringP("format", "f", "color", "Output format: color, json, csv")
+}
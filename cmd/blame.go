@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/spf13/cobra"
+)
+
+var blameCmd = &cobra.Command{
+	Use:   "blame <file>",
+	Short: "Show who last changed each line of a file, colored by age",
+	Long: `Display git blame for a file with lines grouped by commit and colored by age.
+
+Consecutive lines last changed by the same commit are grouped under a single
+hash, author and date. Line colors run from green for the newest changes
+to dark blue for the oldest ones in the file.
+
+Output formats:
+- color (default): Grouped, age-colored blame
+- json: Line ranges with commit, author and date
+
+Examples:
+  glo blame main.go                    # Age-colored blame
+  glo blame main.go --rev=v1.0.0       # Blame as of a revision
+  glo blame main.go --summary          # Ownership percentage per author
+  glo blame main.go --format=json      # Export as JSON`,
+	Args: cobra.ExactArgs(1),
+	Run:  runBlameCommand,
+}
+
+func runBlameCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	revision, _ := cmd.Flags().GetString("rev")
+	summary, _ := cmd.Flags().GetBool("summary")
+
+	resolver, err := loadIdentityResolver(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	blame, err := gitExec.GetBlame(args[0], revision)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running blame: %v\n", err)
+		os.Exit(1)
+	}
+	resolver.ApplyBlame(blame)
+
+	blameFormatter := formatters.NewBlameFormatter(format == "color")
+
+	var output string
+	switch strings.ToLower(format) {
+	case "json":
+		if summary {
+			output, err = blameFormatter.FormatSummaryJSON(blame)
+		} else {
+			output, err = blameFormatter.FormatJSON(blame)
+		}
+		output += "\n"
+	case "color", "":
+		if summary {
+			output = blameFormatter.FormatSummary(blame)
+		} else {
+			output = blameFormatter.FormatColor(blame)
+		}
+	default:
+		err = fmt.Errorf("unknown format '%s'. Use: color or json", format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+}
+
+func init() {
+	rootCmd.AddCommand(blameCmd)
+
+	blameCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+	blameCmd.Flags().StringP("rev", "r", "", "Blame the file as of this revision")
+	blameCmd.Flags().Bool("summary", false, "Show ownership percentages per author")
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/formatters"
 	"github.com/DinethDilhara/glo/internal/gitexec"
 	"github.com/DinethDilhara/glo/internal/models"
 	"github.com/spf13/cobra"
@@ -15,9 +16,18 @@ type BranchConfig struct {
 	Format    string
 	Tree      bool
 	WithDates bool
+	WithAuthor bool
 	Remote    bool
 	All       bool
 	Graph     bool
+	Sort      string
+	Patterns  []string
+	Merged    string
+	NoMerged  string
+	Contains  string
+	Author    string
+	GroupBy   string
+	Verbose   bool
 }
 
 type BranchService struct {
@@ -35,7 +45,35 @@ func NewBranchService() *BranchService {
 }
 
 func (s *BranchService) FetchBranches(config *BranchConfig) ([]models.Branch, error) {
-	return s.gitExec.GetBranches(config.All, config.Remote)
+	return s.gitExec.GetBranches(gitexec.BranchOptions{
+		All:        config.All,
+		RemoteOnly: config.Remote,
+		Sort:       config.Sort,
+		Patterns:   config.Patterns,
+		Merged:     config.Merged,
+		NoMerged:   config.NoMerged,
+		Contains:   config.Contains,
+		Author:     config.Author,
+
+		CountAgainstDefault: config.showsDefaultCounts(),
+	})
+}
+
+// showsDefaultCounts reports whether the branches need their distance from
+// the default branch, which costs one rev-list per branch: to sort by it,
+// or to show it in the table, tree and JSON formats, and in the color
+// format with --verbose.
+func (config *BranchConfig) showsDefaultCounts() bool {
+	if config.Sort == "ahead" || config.Sort == "behind" {
+		return true
+	}
+	switch strings.ToLower(config.Format) {
+	case "table", "tree", "json":
+		return true
+	case "graph":
+		return false
+	}
+	return config.Tree || config.Verbose && !config.Graph
 }
 
 func (f *BranchFormatter) FormatOutput(branches []models.Branch, config *BranchConfig) error {
@@ -78,37 +116,60 @@ func (f *BranchFormatter) formatTable(branches []models.Branch, config *BranchCo
 	fmt.Println(colorFormatter.FormatHeader("Git Branches"))
 	fmt.Println()
 	
-	fmt.Printf("%-20s %-10s %-15s %-50s %s\n", "Branch", "Type", "Last Commit", "Message", "Author")
-	fmt.Println(strings.Repeat("-", 120))
-	
+	nameWidth := 20
 	for _, branch := range branches {
-		typeColor := formatter.ColorGreen
-		if branch.IsRemote {
-			typeColor = formatter.ColorRed
-		}
-		if branch.IsCurrent {
-			typeColor = formatter.ColorYellow
+		nameWidth = max(nameWidth, min(len(branch.Name), 40))
+	}
+	upstreamWidth := formatters.UpstreamColumnWidth(branches)
+	
+	fmt.Printf("%-*s %-10s %-*s %-12s %-25s %-50s %s\n", nameWidth, "Branch", "Type", upstreamWidth, "Upstream", formatters.DefaultColumnHeader(branches), "Last Commit", "Message", "Author")
+	fmt.Println(strings.Repeat("-", nameWidth+110+upstreamWidth+14))
+	
+	groups := []formatters.BranchGroup{{Branches: branches}}
+	if config.GroupBy == "prefix" {
+		groups = formatters.GroupByPrefix(branches)
+	}
+	
+	for i, group := range groups {
+		if config.GroupBy != "" {
+			printGroupHeader(group, i)
 		}
 		
-		branchType := "local"
-		if branch.IsRemote {
-			branchType = "remote"
+		for _, branch := range group.Branches {
+			f.printTableRow(branch, nameWidth, upstreamWidth)
 		}
-		if branch.IsCurrent {
-			branchType = "current"
-		}
-		
-		fmt.Printf("%-20s %s%-10s%s %-15s %-50s %s\n",
-			branch.Name,
-			typeColor, branchType, formatter.ColorReset,
-			branch.LastCommitDate,
-			truncateString(branch.LastCommitMessage, 48),
-			branch.LastCommitAuthor)
 	}
 	
 	return nil
 }
 
+func (f *BranchFormatter) printTableRow(branch models.Branch, nameWidth, upstreamWidth int) {
+	typeColor := formatter.ColorGreen
+	if branch.IsRemote {
+		typeColor = formatter.ColorRed
+	}
+	if branch.IsCurrent {
+		typeColor = formatter.ColorYellow
+	}
+	
+	branchType := "local"
+	if branch.IsRemote {
+		branchType = "remote"
+	}
+	if branch.IsCurrent {
+		branchType = "current"
+	}
+	
+	fmt.Printf("%-*s %s%-10s%s %-*s %-12s %-25s %-50s %s\n",
+		nameWidth, truncateString(branch.Name, nameWidth),
+		typeColor, branchType, formatter.ColorReset,
+		upstreamWidth, truncateString(formatters.FormatUpstream(branch.Upstream), upstreamWidth),
+		formatters.FormatDefaultCounts(branch),
+		formatter.FormatDate(branch.LastCommitDate),
+		truncateString(branch.LastCommitMessage, 48),
+		branch.LastCommitAuthor)
+}
+
 func (f *BranchFormatter) formatTree(branches []models.Branch, config *BranchConfig) error {
 	colorFormatter := formatter.NewColorFormatter()
 	
@@ -130,53 +191,59 @@ func (f *BranchFormatter) formatTree(branches []models.Branch, config *BranchCon
 		}
 	}
 	
+	leaf := func(node *formatters.BranchNode) string {
+		branch := node.Branch
+		branchColor := formatter.ColorGreen
+		indicator := "  "
+		if branch.IsRemote {
+			branchColor = formatter.ColorRed
+			indicator = ""
+		}
+		if branch.IsCurrent {
+			branchColor = formatter.ColorYellow
+			indicator = "* "
+		}
+		
+		label := fmt.Sprintf("%s%s%s%s", indicator, branchColor, node.Label, formatter.ColorReset)
+		if tracking := formatters.FormatTracking(*branch); tracking != "" {
+			label += " " + tracking
+		}
+		if config.WithDates {
+			label += fmt.Sprintf(" %s(%s)%s", formatter.ColorCyan, formatter.FormatDate(branch.LastCommitDate), formatter.ColorReset)
+		}
+		if config.WithAuthor && branch.LastCommitAuthor != "" {
+			label += fmt.Sprintf(" %sby %s%s", formatter.ColorBlue, branch.LastCommitAuthor, formatter.ColorReset)
+		}
+		return label
+	}
+	
 	fmt.Printf("%s📁 Repository%s\n", formatter.ColorBold, formatter.ColorReset)
 	fmt.Printf("│\n")
 	
+	var tree strings.Builder
+	
 	if len(local) > 0 {
-		fmt.Printf("├── %sLocal Branches%s\n", formatter.ColorGreen, formatter.ColorReset)
-		for i, branch := range local {
-			isLast := i == len(local)-1 && len(remote) == 0
-			prefix := "│   ├── "
-			if isLast {
-				prefix = "│   └── "
-			}
-			
-			branchColor := formatter.ColorGreen
-			indicator := "  "
-			if branch.IsCurrent {
-				branchColor = formatter.ColorYellow
-				indicator = "* "
-			}
-			
-			fmt.Printf("%s%s%s%s%s", prefix, indicator, branchColor, branch.Name, formatter.ColorReset)
-			if config.WithDates {
-				fmt.Printf(" %s(%s)%s", formatter.ColorCyan, branch.LastCommitDate, formatter.ColorReset)
-			}
-			fmt.Println()
-		}
+		tree.WriteString(fmt.Sprintf("├── %sLocal Branches%s\n", formatter.ColorGreen, formatter.ColorReset))
+		formatters.WriteBranchTree(&tree, formatters.BranchHierarchy(local), "│   ", leaf)
 	}
 	
 	if len(remote) > 0 {
 		if len(local) > 0 {
-			fmt.Printf("│\n")
+			tree.WriteString("│\n")
 		}
-		fmt.Printf("└── %sRemote Branches%s\n", formatter.ColorRed, formatter.ColorReset)
-		for i, branch := range remote {
-			isLast := i == len(remote)-1
-			prefix := "    ├── "
-			if isLast {
-				prefix = "    └── "
-			}
-			
-			fmt.Printf("%s%s%s%s", prefix, formatter.ColorRed, branch.Name, formatter.ColorReset)
-			if config.WithDates {
-				fmt.Printf(" %s(%s)%s", formatter.ColorCyan, branch.LastCommitDate, formatter.ColorReset)
-			}
-			fmt.Println()
+		tree.WriteString(fmt.Sprintf("└── %sRemote Branches%s\n", formatter.ColorRed, formatter.ColorReset))
+		
+		var remoteNodes []*formatters.BranchNode
+		for _, group := range formatters.GroupByRemote(remote) {
+			remoteNodes = append(remoteNodes, &formatters.BranchNode{
+				Label:    group.Name,
+				Children: formatters.BranchHierarchy(group.Branches),
+			})
 		}
+		formatters.WriteBranchTree(&tree, remoteNodes, "    ", leaf)
 	}
 	
+	fmt.Print(tree.String())
 	fmt.Println()
 	fmt.Printf("%sCurrent branch: %s%s%s\n", formatter.ColorBold, formatter.ColorYellow, current, formatter.ColorReset)
 	
@@ -258,7 +325,7 @@ func (f *BranchFormatter) drawBranchGraph(nodes []BranchGraphNode, config *Branc
 			}
 		}
 		
-		if strings.Contains(commit.Message, "merge") || strings.Contains(strings.ToLower(commit.Message), "merge") {
+		if commit.IsMerge() {
 			fmt.Printf("  %s│%s\n", color, formatter.ColorReset)
 			fmt.Printf("  %s├─╮%s %s%s%s %s\n", 
 				color, formatter.ColorReset,
@@ -288,29 +355,65 @@ func (f *BranchFormatter) drawBranchGraph(nodes []BranchGraphNode, config *Branc
 }
 
 func (f *BranchFormatter) formatColor(branches []models.Branch, config *BranchConfig) error {
+	nameWidth := 0
 	for _, branch := range branches {
-		prefix := "  "
-		color := formatter.ColorGreen
-		
-		if branch.IsCurrent {
-			prefix = "* "
-			color = formatter.ColorYellow
-		} else if branch.IsRemote {
-			color = formatter.ColorRed
+		nameWidth = max(nameWidth, len(branch.Name))
+	}
+	
+	groups := []formatters.BranchGroup{{Branches: branches}}
+	if config.GroupBy == "prefix" {
+		groups = formatters.GroupByPrefix(branches)
+	}
+	
+	for i, group := range groups {
+		if config.GroupBy != "" {
+			printGroupHeader(group, i)
 		}
-		
-		fmt.Printf("%s%s%s%s", prefix, color, branch.Name, formatter.ColorReset)
-		
-		if config.WithDates {
-			fmt.Printf(" %s(%s)%s", formatter.ColorCyan, branch.LastCommitDate, formatter.ColorReset)
+		for _, branch := range group.Branches {
+			f.printColorLine(branch, nameWidth, config)
 		}
-		
-		fmt.Println()
 	}
 	
 	return nil
 }
 
+func (f *BranchFormatter) printColorLine(branch models.Branch, nameWidth int, config *BranchConfig) {
+	prefix := "  "
+	color := formatter.ColorGreen
+	
+	if branch.IsCurrent {
+		prefix = "* "
+		color = formatter.ColorYellow
+	} else if branch.IsRemote {
+		color = formatter.ColorRed
+	}
+	
+	fmt.Printf("%s%s%-*s%s", prefix, color, nameWidth, branch.Name, formatter.ColorReset)
+	
+	if tracking := formatters.FormatTracking(branch); tracking != "" {
+		fmt.Printf(" %s", tracking)
+	}
+	
+	if config.WithDates {
+		fmt.Printf(" %s(%s)%s", formatter.ColorCyan, formatter.FormatDate(branch.LastCommitDate), formatter.ColorReset)
+	}
+	
+	fmt.Println()
+}
+
+// printGroupHeader prints the bold heading of a --group-by group, separated
+// from the group before it by a blank line.
+func printGroupHeader(group formatters.BranchGroup, index int) {
+	name := group.Name
+	if name == "" {
+		name = "(no prefix)"
+	}
+	if index > 0 {
+		fmt.Println()
+	}
+	fmt.Printf("%s%s%s (%d)\n", formatter.ColorBold, name, formatter.ColorReset, len(group.Branches))
+}
+
 func truncateString(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -323,17 +426,44 @@ func (s *BranchService) ExecuteBranchCommand(cmd *cobra.Command, args []string)
 		return fmt.Errorf("not a git repository")
 	}
 
+	if healthReport, _ := cmd.Flags().GetBool("health"); healthReport {
+		return s.ExecuteAuditCommand(cmd)
+	}
+
 	config := &BranchConfig{}
 	config.Format, _ = cmd.Flags().GetString("format")
 	config.Tree, _ = cmd.Flags().GetBool("tree")
 	config.WithDates, _ = cmd.Flags().GetBool("with-dates")
+	config.WithAuthor, _ = cmd.Flags().GetBool("with-author")
 	config.Remote, _ = cmd.Flags().GetBool("remote")
 	config.All, _ = cmd.Flags().GetBool("all")
 	config.Graph, _ = cmd.Flags().GetBool("graph")
+	config.Sort, _ = cmd.Flags().GetString("sort")
+	config.Patterns, _ = cmd.Flags().GetStringSlice("pattern")
+	config.Merged, _ = cmd.Flags().GetString("merged")
+	config.NoMerged, _ = cmd.Flags().GetString("no-merged")
+	config.Contains, _ = cmd.Flags().GetString("contains")
+	config.Author, _ = cmd.Flags().GetString("author")
+	config.GroupBy, _ = cmd.Flags().GetString("group-by")
+	config.Verbose, _ = cmd.Flags().GetBool("verbose")
+	
+	if config.GroupBy != "" && config.GroupBy != "prefix" {
+		return fmt.Errorf("unknown grouping '%s'. Use: prefix", config.GroupBy)
+	}
 	
 	if config.Format == "" {
 		config.Format, _ = cmd.Parent().PersistentFlags().GetString("format")
 	}
+	
+	if config.GroupBy != "" {
+		switch strings.ToLower(config.Format) {
+		case "json", "graph":
+			return fmt.Errorf("--group-by works with the color, table and tree formats, not %s", config.Format)
+		}
+		if config.Graph {
+			return fmt.Errorf("--group-by cannot be combined with --graph")
+		}
+	}
 
 	branches, err := s.FetchBranches(config)
 	if err != nil {
@@ -361,16 +491,30 @@ The branch command allows you to:
 - View branches in different formats (color, table, tree, graph)
 - See branch relationships and merge history
 - Include commit dates and author information
+- See each branch's upstream, ahead/behind counts and distance from the
+  default branch
 - Visualize branch structure with ASCII art
 
 Examples:
   glo branch                          # Show branches with colors
-  glo branch --tree                   # Show as tree structure
+  glo branch --tree                   # Show as tree nested by name (feature/auth/...)
+  glo branch --tree -d --with-author  # Tree with last commit dates and authors
   glo branch --graph                  # Show ASCII commit graph
   glo branch --format=table           # Show as detailed table
   glo branch --with-dates             # Include last commit dates
+  glo branch -v                       # Include distance from the default branch
   glo branch --all                    # Show all branches (local + remote)
-  glo branch --remote                 # Show only remote branches`,
+  glo branch --remote                 # Show only remote branches
+  glo branch --sort=date              # Most recently updated first
+  glo branch --sort=behind            # Furthest behind the default branch first
+  glo branch --pattern='feature/*'    # Only feature branches
+  glo branch --merged=main            # Branches already merged into main
+  glo branch --no-merged=main         # Branches with unmerged work
+  glo branch --contains=abc123        # Branches containing a commit
+  glo branch --author=alice           # Branches last committed to by alice
+  glo branch -f table --group-by=prefix  # Sections for feature/, bugfix/, ...
+  glo branch --health                 # Classify branches for cleanup (see glo branch audit)
+  glo branch prune                    # Preview deleting merged and gone branches`,
 	Run: runBranchCommand,
 }
 
@@ -381,6 +525,17 @@ func init() {
 	branchCmd.Flags().BoolP("tree", "t", false, "Show branches as tree structure")
 	branchCmd.Flags().BoolP("graph", "g", false, "Show ASCII commit graph with branches")
 	branchCmd.Flags().BoolP("with-dates", "d", false, "Include last commit dates")
+	branchCmd.Flags().BoolP("verbose", "v", false, "Include how far each branch is ahead of and behind the default branch in the color format")
+	branchCmd.Flags().Bool("with-author", false, "Include the author of the last commit in the tree format")
 	branchCmd.Flags().BoolP("remote", "r", false, "Show only remote branches")
 	branchCmd.Flags().BoolP("all", "a", false, "Show all branches (local and remote)")
+	branchCmd.Flags().String("sort", "", "Sort by: "+strings.Join(gitexec.BranchSorts, ", "))
+	branchCmd.Flags().StringSlice("pattern", nil, "Only show branches matching these globs (e.g. 'feature/*')")
+	branchCmd.Flags().String("merged", "", "Only show branches merged into this ref")
+	branchCmd.Flags().String("no-merged", "", "Only show branches not merged into this ref")
+	branchCmd.Flags().String("contains", "", "Only show branches containing this commit")
+	branchCmd.Flags().String("author", "", "Only show branches whose last commit author matches this regex")
+	branchCmd.Flags().String("group-by", "", "Group branches in the color and table formats: prefix (the tree is always nested)")
+	branchCmd.Flags().Bool("health", false, "Show the branch health report instead of the branch list")
+	addBranchAuditFlags(branchCmd)
 }
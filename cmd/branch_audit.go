@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/health"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var branchAuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Classify local branches as active, merged, empty, stale or diverged",
+	Long: `Report the health of every local branch to guide cleanup.
+
+For each branch the audit computes:
+- commits ahead of and behind the default branch (--base)
+- whether it is fully merged into the default branch
+- the age of its last commit
+- its upstream, ahead/behind counts against it, and whether it is gone
+
+Branches are then classified:
+- merged:   nothing ahead of the base, which has moved on since
+- empty:    at the tip of the base with no commits of its own
+- stale:    unmerged and no commits for more than --stale-days
+- diverged: commits of its own and more than --diverged-behind commits
+            behind the base, or both ahead of and behind its upstream
+- active:   everything else
+
+The default branch is the local counterpart of origin/HEAD, or the first of
+main, master, trunk and develop that exists.
+
+Output formats:
+- color (default): Table of branches with status and reasons
+- json: Full report for automation
+
+Examples:
+  glo branch audit                           # Audit all local branches
+  glo branch --health                        # Same as glo branch audit
+  glo branch audit --status=stale,merged     # Only cleanup candidates
+  glo branch audit --gone                    # Branches whose upstream is gone
+  glo branch audit --base=develop --stale-days=30
+  glo branch audit --format=json             # Export for automation`,
+	Run: runBranchAuditCommand,
+}
+
+func addBranchAuditFlags(cmd *cobra.Command) {
+	cmd.Flags().String("base", "", "Branch to measure against (default: detected default branch)")
+	cmd.Flags().Int("stale-days", 90, "Days without commits after which an unmerged branch is stale")
+	cmd.Flags().Int("diverged-behind", 50, "Commits behind the base after which a branch with commits of its own is diverged")
+	cmd.Flags().StringSlice("status", nil, "Only show branches with these statuses: active, merged, empty, stale, diverged")
+	cmd.Flags().Bool("gone", false, "Only show branches whose upstream is gone")
+}
+
+func runBranchAuditCommand(cmd *cobra.Command, args []string) {
+	branchService := NewBranchService()
+
+	if err := branchService.ExecuteAuditCommand(cmd); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func (s *BranchService) ExecuteAuditCommand(cmd *cobra.Command) error {
+	if !s.gitExec.IsGitRepository() {
+		return fmt.Errorf("not a git repository")
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	base, _ := cmd.Flags().GetString("base")
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+	goneOnly, _ := cmd.Flags().GetBool("gone")
+
+	opts := health.Options{Now: time.Now()}
+	opts.StaleAfterDays, _ = cmd.Flags().GetInt("stale-days")
+	opts.DivergedBehind, _ = cmd.Flags().GetInt("diverged-behind")
+
+	for _, status := range statuses {
+		if !health.ValidStatus(strings.ToLower(status)) {
+			return fmt.Errorf("unknown status '%s'. Use: %s", status, strings.Join(health.Statuses, ", "))
+		}
+	}
+
+	branches, base, err := s.FetchBranchHealth(base)
+	if err != nil {
+		return err
+	}
+
+	if goneOnly {
+		var gone []models.BranchHealth
+		for _, branch := range branches {
+			if branch.UpstreamGone() {
+				gone = append(gone, branch)
+			}
+		}
+		branches = gone
+	}
+
+	report := health.Report(base, branches, statuses, opts)
+	healthFormatter := formatters.NewBranchHealthFormatter(format != "json")
+
+	switch strings.ToLower(format) {
+	case "json":
+		output, err := healthFormatter.FormatJSON(report)
+		if err != nil {
+			return err
+		}
+		fmt.Println(output)
+	case "color", "":
+		fmt.Print(healthFormatter.FormatColor(report))
+	default:
+		return fmt.Errorf("unknown format '%s'. Use: color or json", format)
+	}
+
+	return nil
+}
+
+// FetchBranchHealth gathers ahead/behind counts against base and upstream
+// state for every local branch other than base itself. Counts GetBranches
+// already made against the default branch are reused. An empty base is
+// replaced by the repository's default branch, which is returned.
+func (s *BranchService) FetchBranchHealth(base string) ([]models.BranchHealth, string, error) {
+	if base == "" {
+		var err error
+		base, err = s.gitExec.GetDefaultBranch()
+		if err != nil || base == "" {
+			return nil, "", fmt.Errorf("could not determine the default branch; use --base")
+		}
+	}
+
+	branches, err := s.gitExec.GetBranches(gitexec.BranchOptions{CountAgainstDefault: true})
+	if err != nil {
+		return nil, "", fmt.Errorf("error fetching branches: %v", err)
+	}
+
+	var healths []models.BranchHealth
+	for _, branch := range branches {
+		if branch.Name == base {
+			continue
+		}
+
+		ahead, behind := branch.AheadDefault, branch.BehindDefault
+		if branch.DefaultBranch != base {
+			ahead, behind, err = s.gitExec.GetAheadBehind(base, branch.RefName)
+			if err != nil {
+				return nil, "", fmt.Errorf("error comparing %s with %s: %v", branch.Name, base, err)
+			}
+		}
+
+		entry := models.BranchHealth{
+			Name:             branch.Name,
+			IsCurrent:        branch.IsCurrent,
+			Hash:             branch.LastCommitHash,
+			LastCommitDate:   branch.LastCommitDate,
+			LastCommitAuthor: branch.LastCommitAuthor,
+			AheadBase:        ahead,
+			BehindBase:       behind,
+			Upstream:         branch.Upstream,
+		}
+		healths = append(healths, entry)
+	}
+
+	return healths, base, nil
+}
+
+func init() {
+	branchCmd.AddCommand(branchAuditCmd)
+
+	branchAuditCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+	addBranchAuditFlags(branchAuditCmd)
+}
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/health"
+	"github.com/DinethDilhara/glo/internal/prune"
+	"github.com/spf13/cobra"
+)
+
+var branchPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete merged and gone local branches, dry-run by default",
+	Long: `Find local branches that can be cleaned up and optionally delete them.
+
+A branch is a candidate when it is fully merged into the target branch or
+its upstream was deleted ("gone"), and its last commit is at least
+--older-than days old. The target, the current branch and protected branches
+are never deleted. Protected patterns are main, master, develop, trunk and
+release/* plus any given with --protect or listed under
+"protected_branches" in .glo.json.
+
+Without --delete nothing is changed: the command only prints what would be
+removed and why. With --delete it asks for confirmation unless --yes is
+given. The tip of every deleted branch is recorded in the git directory
+(` + prune.LogFile + `) and can be brought back with glo branch restore.
+
+Examples:
+  glo branch prune                           # Show what would be deleted
+  glo branch prune --older-than=30           # Only branches idle for 30 days
+  glo branch prune --protect='hotfix/*'      # Keep hotfix branches
+  glo branch prune --no-gone                 # Only merged branches
+  glo branch prune --delete                  # Delete after confirmation
+  glo branch prune --yes                     # Delete without asking`,
+	Run: runBranchPruneCommand,
+}
+
+var branchRestoreCmd = &cobra.Command{
+	Use:   "restore [branch...]",
+	Short: "Restore branches deleted by glo branch prune",
+	Long: `Recreate branches deleted by glo branch prune at their recorded tips.
+
+Without arguments, lists the recorded deletions, most recent first.
+
+Examples:
+  glo branch restore                         # List pruned branches
+  glo branch restore feature/old-login       # Bring a branch back`,
+	Run: runBranchRestoreCommand,
+}
+
+func runBranchPruneCommand(cmd *cobra.Command, args []string) {
+	branchService := NewBranchService()
+
+	if err := branchService.ExecutePruneCommand(cmd); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runBranchRestoreCommand(cmd *cobra.Command, args []string) {
+	branchService := NewBranchService()
+
+	if err := branchService.ExecuteRestoreCommand(args); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func (s *BranchService) ExecutePruneCommand(cmd *cobra.Command) error {
+	if !s.gitExec.IsGitRepository() {
+		return fmt.Errorf("not a git repository")
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	target, _ := cmd.Flags().GetString("target")
+	extraProtected, _ := cmd.Flags().GetStringSlice("protect")
+	noMerged, _ := cmd.Flags().GetBool("no-merged")
+	noGone, _ := cmd.Flags().GetBool("no-gone")
+	remove, _ := cmd.Flags().GetBool("delete")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	cfg, err := loadConfig(s.gitExec)
+	if err != nil {
+		return fmt.Errorf("error reading config: %v", err)
+	}
+
+	opts := prune.Options{
+		Merged: !noMerged,
+		Gone:   !noGone,
+	}
+	opts.OlderThanDays, _ = cmd.Flags().GetInt("older-than")
+	opts.Protected = append(opts.Protected, prune.DefaultProtected...)
+	opts.Protected = append(opts.Protected, cfg.ProtectedBranches...)
+	opts.Protected = append(opts.Protected, extraProtected...)
+
+	branches, target, err := s.FetchBranchHealth(target)
+	if err != nil {
+		return err
+	}
+	opts.Target = target
+
+	now := time.Now()
+	for i := range branches {
+		health.Classify(&branches[i], health.Options{Now: now})
+	}
+	candidates, protected := prune.Candidates(branches, opts)
+
+	pruneFormatter := formatters.NewPruneFormatter(format != "json")
+	remove = remove || yes
+
+	switch strings.ToLower(format) {
+	case "json":
+		if remove && !yes {
+			return fmt.Errorf("--format=json cannot prompt for confirmation; add --yes")
+		}
+		output, err := pruneFormatter.FormatJSON(target, candidates, protected, remove)
+		if err != nil {
+			return err
+		}
+		fmt.Println(output)
+	case "color", "table", "":
+		fmt.Print(pruneFormatter.FormatTable(target, candidates, protected))
+	default:
+		return fmt.Errorf("unknown format '%s'. Use: color or json", format)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+	if !remove {
+		if format != "json" {
+			fmt.Printf("\nDry run: nothing was deleted. Re-run with --delete to remove %d branch(es).\n", len(candidates))
+		}
+		return nil
+	}
+	if !yes && !confirm(fmt.Sprintf("\nDelete %d branch(es)? [y/N] ", len(candidates))) {
+		fmt.Println("Aborted.")
+		return nil
+	}
+
+	gitDir, err := s.gitExec.GetGitDir()
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		hash, err := s.gitExec.DeleteBranch(candidate.Name)
+		if err != nil {
+			return fmt.Errorf("error deleting %s: %v", candidate.Name, err)
+		}
+
+		candidate.Hash = hash
+		if err := prune.Record(gitDir, prune.NewRecord(candidate, now)); err != nil {
+			return fmt.Errorf("deleted %s (was %s) but could not record it: %v", candidate.Name, hash, err)
+		}
+		if format != "json" {
+			fmt.Printf("Deleted %s (was %s)\n", candidate.Name, hash[:8])
+		}
+	}
+
+	if format != "json" {
+		fmt.Printf("\nTips recorded in %s; use glo branch restore <branch> to undo.\n", filepath.Join(gitDir, prune.LogFile))
+	}
+	return nil
+}
+
+func (s *BranchService) ExecuteRestoreCommand(names []string) error {
+	if !s.gitExec.IsGitRepository() {
+		return fmt.Errorf("not a git repository")
+	}
+
+	gitDir, err := s.gitExec.GetGitDir()
+	if err != nil {
+		return err
+	}
+
+	history, err := prune.History(gitDir)
+	if err != nil {
+		return fmt.Errorf("error reading prune history: %v", err)
+	}
+
+	if len(names) == 0 {
+		fmt.Print(formatters.NewPruneFormatter(true).FormatHistory(history))
+		return nil
+	}
+
+	for _, name := range names {
+		pruned, ok := prune.Latest(history, name)
+		if !ok {
+			return fmt.Errorf("no recorded deletion of branch '%s'", name)
+		}
+		if err := s.gitExec.CreateBranch(pruned.Name, pruned.Hash); err != nil {
+			return fmt.Errorf("error restoring %s: %v", name, err)
+		}
+		fmt.Printf("Restored %s at %s\n", pruned.Name, pruned.Hash[:8])
+	}
+
+	return nil
+}
+
+// confirm asks a yes/no question on stdin; anything but y or yes is no.
+func confirm(question string) bool {
+	fmt.Print(question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Println()
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func init() {
+	branchCmd.AddCommand(branchPruneCmd)
+	branchCmd.AddCommand(branchRestoreCmd)
+
+	branchPruneCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+	branchPruneCmd.Flags().String("target", "", "Branch that merged branches are merged into (default: detected default branch)")
+	branchPruneCmd.Flags().Int("older-than", 0, "Only prune branches whose last commit is at least this many days old")
+	branchPruneCmd.Flags().StringSlice("protect", nil, "Additional glob patterns of branches never to prune")
+	branchPruneCmd.Flags().Bool("no-merged", false, "Do not prune branches merged into the target")
+	branchPruneCmd.Flags().Bool("no-gone", false, "Do not prune branches whose upstream is gone")
+	branchPruneCmd.Flags().Bool("delete", false, "Delete the branches after confirmation instead of a dry run")
+	branchPruneCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation (implies --delete)")
+}
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var compareCmd = &cobra.Command{
+	Use:   "compare <a> <b>",
+	Short: "Compare two branches: unique commits, merge base and changed files",
+	Long: `Compare two branches or other revisions.
+
+Shows the merge base of <a> and <b>, the commits only <a> has and the
+commits only <b> has, the files <b> changes since the merge base (what
+git diff a...b shows) and whether merging <b> into <a> would be a
+fast-forward.
+
+Output formats:
+- color (default): Commit lists and a diff stat
+- markdown: A report for pull request descriptions
+- json: The full comparison
+
+Examples:
+  glo compare main feature/login           # Compare a branch with main
+  glo compare main HEAD --limit=10         # At most 10 commits per side
+  glo compare v1.0.0 v1.1.0 -f markdown    # Release comparison as Markdown
+  glo compare main feature/login -f json   # Export as JSON`,
+	Args: cobra.ExactArgs(2),
+	Run:  runCompareCommand,
+}
+
+func runCompareCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	comparison, err := compareRevisions(gitExec, args[0], args[1], limit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	compareFormatter := formatters.NewCompareFormatter(format == "color")
+
+	switch strings.ToLower(format) {
+	case "json":
+		output, err := compareFormatter.FormatJSON(comparison)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(output)
+	case "markdown", "md":
+		fmt.Print(compareFormatter.FormatMarkdown(comparison))
+	case "color", "":
+		fmt.Print(compareFormatter.FormatColor(comparison))
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown format '%s'. Use: color, markdown or json\n", format)
+		os.Exit(1)
+	}
+}
+
+// compareRevisions gathers the commits unique to each of left and right, at
+// most limit per side when limit is positive, and the files right changes
+// since the merge base.
+func compareRevisions(gitExec *gitexec.GitExecutor, left, right string, limit int) (*models.Comparison, error) {
+	for _, revision := range []string{left, right} {
+		if strings.HasPrefix(revision, "-") {
+			return nil, fmt.Errorf("invalid revision '%s'", revision)
+		}
+	}
+
+	mergeBase, err := gitExec.GetMergeBase(left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &models.Comparison{
+		Left:      left,
+		Right:     right,
+		MergeBase: mergeBase,
+	}
+
+	comparison.RightCount, comparison.LeftCount, err = gitExec.GetAheadBehind(left, right)
+	if err != nil {
+		return nil, err
+	}
+	comparison.UpToDate = comparison.RightCount == 0
+	comparison.FastForward = comparison.LeftCount == 0 && comparison.RightCount > 0
+
+	resolver, err := loadIdentityResolver(gitExec)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config: %v", err)
+	}
+	extractor, err := loadReferenceExtractor(gitExec)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config: %v", err)
+	}
+
+	sides := []struct {
+		commits  *[]models.Commit
+		revision string
+		side     string
+	}{
+		{&comparison.LeftOnly, right + ".." + left, models.SideLeft},
+		{&comparison.RightOnly, left + ".." + right, models.SideRight},
+	}
+	for _, s := range sides {
+		commits, err := gitExec.GetGitLogs(gitexec.LogOptions{
+			Revisions: []string{s.revision},
+			MaxCount:  limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error reading commits: %v", err)
+		}
+		resolver.ApplyCommits(commits)
+		extractor.ApplyCommits(commits)
+		for i := range commits {
+			commits[i].Side = s.side
+		}
+		*s.commits = commits
+	}
+
+	// Without a merge base there is no three-dot diff to summarize.
+	if mergeBase != "" {
+		comparison.Files, err = gitExec.GetDiffStat(left, right)
+		if err != nil {
+			return nil, fmt.Errorf("error reading diff: %v", err)
+		}
+		for _, change := range comparison.Files {
+			comparison.Additions += change.Additions
+			comparison.Deletions += change.Deletions
+		}
+	}
+
+	return comparison, nil
+}
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+
+	compareCmd.Flags().StringP("format", "f", "color", "Output format: color, markdown, json")
+	compareCmd.Flags().IntP("limit", "l", 0, "Show at most this many commits per side (0 = no limit)")
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/config"
+	"github.com/DinethDilhara/glo/internal/dateparse"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/identity"
+	"github.com/DinethDilhara/glo/internal/references"
+)
+
+// loadConfig reads the user config and, inside a repository, its .glo.json.
+func loadConfig(gitExec *gitexec.GitExecutor) (*config.Config, error) {
+	root, err := gitExec.GetRepositoryRoot()
+	if err != nil {
+		root = ""
+	}
+	return config.Load(root)
+}
+
+func loadIdentityResolver(gitExec *gitexec.GitExecutor) (*identity.Resolver, error) {
+	cfg, err := loadConfig(gitExec)
+	if err != nil {
+		return nil, err
+	}
+	return identity.NewResolver(cfg.Aliases), nil
+}
+
+func loadReferenceExtractor(gitExec *gitexec.GitExecutor) (*references.Extractor, error) {
+	cfg, err := loadConfig(gitExec)
+	if err != nil {
+		return nil, err
+	}
+	return references.NewExtractor(cfg.References)
+}
+
+// loadSprint reads the configured sprint cadence. It returns nil when none
+// is configured, so that "this sprint" reports how to set it.
+func loadSprint(gitExec *gitexec.GitExecutor) (*dateparse.Sprint, error) {
+	cfg, err := loadConfig(gitExec)
+	if err != nil || cfg.Sprint == nil {
+		return nil, err
+	}
+	start, err := time.ParseInLocation("2006-01-02", cfg.Sprint.Start, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint start '%s', expected YYYY-MM-DD", cfg.Sprint.Start)
+	}
+	if cfg.Sprint.Days <= 0 {
+		return nil, fmt.Errorf("invalid sprint days %d, expected a positive number", cfg.Sprint.Days)
+	}
+	return &dateparse.Sprint{Start: start, Days: cfg.Sprint.Days}, nil
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var conflictsCmd = &cobra.Command{
+	Use:   "conflicts <branch> [<target>]",
+	Short: "Predict whether merging a branch would conflict",
+	Long: `Run a trial merge of <branch> into <target> and report conflicts.
+
+The merge is done in memory with git merge-tree --write-tree (git 2.38 or
+newer); the working tree, index and refs are not touched. <target> defaults
+to the repository's default branch. Conflicting files are listed with the
+kind of conflict and the conflicted hunks.
+
+With --all every local branch is merged into the target instead, giving a
+table of which branches would conflict.
+
+The command exits with status 1 when any merge would conflict, so it can
+be used as a CI gate, and with status 2 on errors. With --all a branch that
+cannot be merged at all, such as one with unrelated history, is reported
+with its error and the other branches are still checked; the exit status
+is then 2.
+
+Examples:
+  glo conflicts feature/login              # Would feature/login merge into main?
+  glo conflicts feature/login develop      # ... into develop
+  glo conflicts feature/login --hunks      # Show the conflicting lines
+  glo conflicts --all                      # Check every branch against main
+  glo conflicts --all -f json              # Machine-readable for CI`,
+	Args: cobra.MaximumNArgs(2),
+	Run:  runConflictsCommand,
+}
+
+func runConflictsCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(2)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	all, _ := cmd.Flags().GetBool("all")
+	showHunks, _ := cmd.Flags().GetBool("hunks")
+
+	var branches []string
+	var target string
+	switch {
+	case all && len(args) > 1:
+		fmt.Fprintf(os.Stderr, "Error: --all takes at most a target branch\n")
+		os.Exit(2)
+	case all && len(args) == 1:
+		target = args[0]
+	case !all && len(args) == 0:
+		fmt.Fprintf(os.Stderr, "Error: a branch is required unless --all is given\n")
+		os.Exit(2)
+	case !all:
+		branches = args[:1]
+		if len(args) == 2 {
+			target = args[1]
+		}
+	}
+
+	if target == "" {
+		var err error
+		target, err = gitExec.GetDefaultBranch()
+		if err != nil || target == "" {
+			fmt.Fprintf(os.Stderr, "Error: could not determine the default branch; pass a target\n")
+			os.Exit(2)
+		}
+	}
+
+	if all {
+		local, err := gitExec.GetBranches(gitexec.BranchOptions{})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching branches: %v\n", err)
+			os.Exit(2)
+		}
+		for _, branch := range local {
+			if branch.Name != target {
+				branches = append(branches, branch.Name)
+			}
+		}
+	}
+
+	report := &models.ConflictReport{Target: target}
+	for _, branch := range branches {
+		prediction, err := gitExec.TrialMerge(target, branch)
+		if err != nil && !all {
+			fmt.Fprintf(os.Stderr, "Error merging %s into %s: %v\n", branch, target, err)
+			os.Exit(2)
+		}
+		if err != nil {
+			prediction = &models.MergePrediction{Branch: branch, Target: target, Error: err.Error()}
+			report.Failed++
+		} else if !prediction.Clean {
+			report.Conflicting++
+		}
+		report.Results = append(report.Results, *prediction)
+	}
+
+	conflictsFormatter := formatters.NewConflictsFormatter(format == "color", showHunks)
+
+	switch strings.ToLower(format) {
+	case "json":
+		output, err := conflictsFormatter.FormatJSON(report)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Println(output)
+	case "color", "":
+		fmt.Print(conflictsFormatter.FormatColor(report))
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown format '%s'. Use: color or json\n", format)
+		os.Exit(2)
+	}
+
+	if report.Failed > 0 {
+		os.Exit(2)
+	}
+	if report.Conflicting > 0 {
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(conflictsCmd)
+
+	conflictsCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+	conflictsCmd.Flags().Bool("all", false, "Check every local branch against the target")
+	conflictsCmd.Flags().Bool("hunks", false, "Show the conflicting lines of each hunk")
+}
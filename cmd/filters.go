@@ -0,0 +1,334 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/dateparse"
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/DinethDilhara/glo/internal/query"
+	"github.com/spf13/cobra"
+)
+
+// commitFilters holds the commit selection flags shared by every command
+// that reads history through GetGitLogs.
+type commitFilters struct {
+	Revisions   []string
+	All         bool
+	LeftRight   bool
+	FirstParent bool
+
+	Author  string
+	Since   string
+	Until   string
+	Message string
+	Where   string
+	Limit   int
+	Paths   []string
+	Follow  bool
+
+	Merges      bool
+	NoMerges    bool
+	PullRequest string
+	SignedOnly  bool
+	Unsigned    bool
+	Signatures  bool
+
+	CoAuthors bool
+
+	SinceTime time.Time
+	UntilTime time.Time
+	Query     *query.Query
+	// AuthorPattern is Author compiled for matching co-authors in Go;
+	// without --co-authors git matches Author itself.
+	AuthorPattern *regexp.Regexp
+}
+
+func addCommitFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("author", "a", "", "Filter commits by author name")
+	cmd.Flags().StringP("since", "s", "", "Show commits since date (YYYY-MM-DD, \"2 weeks ago\", \"last monday\", \"Q3 2025\", \"2025-W14\")")
+	cmd.Flags().StringP("until", "u", "", "Show commits until date (same forms as --since)")
+	cmd.Flags().StringP("message", "m", "", "Filter commits containing message")
+	cmd.Flags().StringP("where", "w", "", "Filter with an expression over "+query.Fields)
+	cmd.Flags().IntP("limit", "l", 0, "Limit number of commits (0 = no limit)")
+	cmd.Flags().Bool("follow", false, "Follow renames of a single file given after --")
+	cmd.Flags().Bool("all", false, "Walk commits reachable from all refs instead of HEAD")
+	cmd.Flags().Bool("left-right", false, "Mark which side of a symmetric range (A...B) each commit is on")
+	cmd.Flags().Bool("merges", false, "Only show merge commits")
+	cmd.Flags().Bool("no-merges", false, "Leave out merge commits")
+	cmd.Flags().Bool("signed-only", false, "Only show commits with a good signature from a trusted key")
+	cmd.Flags().Bool("unsigned", false, "Only show commits without a good signature")
+	cmd.Flags().Bool("signatures", false, "Verify signatures: mark signed commits and count unsigned ones in summaries")
+	cmd.Flags().String("pr", "", "Only show commits referencing this pull request number, e.g. 1234 or #1234")
+	cmd.Flags().Bool("first-parent", false, "Follow only the first parent of merges, the history of the branch merged into")
+	cmd.Flags().Bool("co-authors", false, "Match --author against Co-authored-by trailers too and credit co-authors in summaries")
+}
+
+// readCommitFilters reads the filter flags, the revisions given as arguments
+// and the pathspecs given after "--".
+func readCommitFilters(cmd *cobra.Command, args []string) (*commitFilters, error) {
+	filters := &commitFilters{}
+	filters.Author, _ = cmd.Flags().GetString("author")
+	filters.Since, _ = cmd.Flags().GetString("since")
+	filters.Until, _ = cmd.Flags().GetString("until")
+	filters.Message, _ = cmd.Flags().GetString("message")
+	filters.Where, _ = cmd.Flags().GetString("where")
+	filters.Limit, _ = cmd.Flags().GetInt("limit")
+	filters.Follow, _ = cmd.Flags().GetBool("follow")
+	filters.All, _ = cmd.Flags().GetBool("all")
+	filters.LeftRight, _ = cmd.Flags().GetBool("left-right")
+	filters.Merges, _ = cmd.Flags().GetBool("merges")
+	filters.NoMerges, _ = cmd.Flags().GetBool("no-merges")
+	filters.FirstParent, _ = cmd.Flags().GetBool("first-parent")
+	filters.PullRequest, _ = cmd.Flags().GetString("pr")
+	filters.PullRequest = strings.TrimPrefix(filters.PullRequest, "#")
+	filters.SignedOnly, _ = cmd.Flags().GetBool("signed-only")
+	filters.Unsigned, _ = cmd.Flags().GetBool("unsigned")
+	filters.Signatures, _ = cmd.Flags().GetBool("signatures")
+	filters.Signatures = filters.Signatures || filters.SignedOnly || filters.Unsigned
+	filters.CoAuthors, _ = cmd.Flags().GetBool("co-authors")
+
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		filters.Paths = args[dash:]
+		args = args[:dash]
+	}
+	filters.Revisions = args
+
+	for _, revision := range filters.Revisions {
+		if strings.HasPrefix(revision, "-") {
+			return nil, fmt.Errorf("invalid revision '%s'", revision)
+		}
+	}
+	if filters.LeftRight && !hasSymmetricRange(filters.Revisions) {
+		return nil, fmt.Errorf("--left-right requires a symmetric range such as main...feature")
+	}
+	if filters.Merges && filters.NoMerges {
+		return nil, fmt.Errorf("--merges and --no-merges cannot be combined")
+	}
+	if filters.SignedOnly && filters.Unsigned {
+		return nil, fmt.Errorf("--signed-only and --unsigned cannot be combined")
+	}
+	if filters.Follow && len(filters.Paths) != 1 {
+		return nil, fmt.Errorf("--follow requires exactly one path after --")
+	}
+
+	now := time.Now()
+	sprint, err := loadSprint(gitexec.NewGitExecutor())
+	if err != nil {
+		return nil, err
+	}
+
+	filters.SinceTime, filters.UntilTime, err = dateparse.ResolveSinceUntil(filters.Since, filters.Until, now, sprint)
+	if err != nil {
+		return nil, err
+	}
+
+	if filters.Where != "" {
+		filters.Query, err = query.Parse(filters.Where, now, sprint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --where: %v", err)
+		}
+	}
+
+	if filters.CoAuthors && filters.Author != "" {
+		filters.AuthorPattern, err = regexp.Compile("(?i)" + filters.Author)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --author: %v", err)
+		}
+	}
+
+	return filters, nil
+}
+
+// FetchCommits runs git log with everything git can filter on itself and
+// applies the rest in Go. Authors are mapped through .mailmap and the
+// configured aliases before filtering. --limit is applied after all
+// filtering, so it is only handed to git when nothing is filtered afterwards.
+func (f *commitFilters) FetchCommits(gitExec *gitexec.GitExecutor) ([]models.Commit, error) {
+	// git only matches --author against the author, so with co-authors the
+	// author filter is applied in Go.
+	gitAuthor := f.Author
+	if f.CoAuthors {
+		gitAuthor = ""
+	}
+
+	opts := gitexec.LogOptions{
+		Revisions:   f.Revisions,
+		All:         f.All,
+		LeftRight:   f.LeftRight,
+		Author:      gitAuthor,
+		Since:       gitDate(f.SinceTime),
+		Until:       gitDate(f.UntilTime),
+		Paths:       f.Paths,
+		Follow:      f.Follow,
+		WithFiles:   len(f.Paths) > 0,
+		Merges:      f.Merges,
+		NoMerges:    f.NoMerges,
+		FirstParent: f.FirstParent,
+		Signatures:  f.Signatures,
+	}
+
+	filterInGo := f.Message != "" || f.Query != nil || gitAuthor != f.Author || f.PullRequest != "" || f.SignedOnly || f.Unsigned
+	if !filterInGo {
+		opts.MaxCount = f.Limit
+	}
+
+	// Pathspecs are relative to the current directory, changed files to the
+	// repository root.
+	var prefix string
+	if len(f.Paths) > 0 || f.Query != nil && f.Query.NeedsFiles() {
+		var err error
+		if prefix, err = gitExec.GetPathPrefix(); err != nil {
+			return nil, err
+		}
+	}
+	if f.Query != nil {
+		f.Query.Apply(&opts)
+		f.Query.SetPathPrefix(prefix)
+		if err := f.Query.ResolveBranches(gitExec.GetReachableCommits); err != nil {
+			return nil, err
+		}
+	}
+
+	resolver, err := loadIdentityResolver(gitExec)
+	if err != nil {
+		return nil, err
+	}
+	extractor, err := loadReferenceExtractor(gitExec)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Author != "" {
+		for _, pattern := range resolver.AuthorPatterns(opts.Author) {
+			opts.Args = append(opts.Args, "--author="+pattern)
+		}
+	}
+
+	commits, err := gitExec.GetGitLogs(opts)
+	if err != nil {
+		return nil, err
+	}
+	resolver.ApplyCommits(commits)
+	extractor.ApplyCommits(commits)
+
+	if len(f.Paths) > 0 {
+		f.markTouchedPaths(commits, prefix)
+	}
+	if gitAuthor != f.Author {
+		commits = filterCommitsByAuthors(commits, f.AuthorPattern)
+	}
+	if f.Message != "" {
+		commits = filterCommitsByMessage(commits, f.Message)
+	}
+	if f.PullRequest != "" {
+		commits = filterCommitsByPullRequest(commits, f.PullRequest)
+	}
+	if f.SignedOnly || f.Unsigned {
+		commits = filterCommitsBySignature(commits, f.SignedOnly)
+	}
+	if f.Query != nil {
+		commits = f.Query.Filter(commits)
+	}
+	if filterInGo && f.Limit > 0 && len(commits) > f.Limit {
+		commits = commits[:f.Limit]
+	}
+
+	return commits, nil
+}
+
+func (f *commitFilters) SummaryOptions() formatter.SummaryOptions {
+	return formatter.SummaryOptions{
+		Since:      f.SinceTime,
+		Until:      f.UntilTime,
+		Paths:      f.Paths,
+		CoAuthors:  f.CoAuthors,
+		Signatures: f.Signatures,
+	}
+}
+
+// filterCommitsByAuthors keeps commits whose author or a co-author matches
+// re, which is tried against "Name <email>" like git's --author.
+func filterCommitsByAuthors(commits []models.Commit, re *regexp.Regexp) []models.Commit {
+	var filtered []models.Commit
+	for _, commit := range commits {
+		people := append(commit.Authors(), models.Person{Name: commit.RawAuthor, Email: commit.RawAuthorEmail})
+		for _, person := range people {
+			if re.MatchString(person.Name + " <" + person.Email + ">") {
+				filtered = append(filtered, commit)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func filterCommitsByPullRequest(commits []models.Commit, number string) []models.Commit {
+	var filtered []models.Commit
+	for _, commit := range commits {
+		if slices.Contains(commit.PullRequests(), number) {
+			filtered = append(filtered, commit)
+		}
+	}
+	return filtered
+}
+
+// filterCommitsBySignature keeps the commits with a good signature when
+// signed is true and the others when it is false.
+func filterCommitsBySignature(commits []models.Commit, signed bool) []models.Commit {
+	var filtered []models.Commit
+	for _, commit := range commits {
+		if commit.HasValidSignature() == signed {
+			filtered = append(filtered, commit)
+		}
+	}
+	return filtered
+}
+
+// markTouchedPaths records which of the requested paths each commit changed.
+// The paths are relative to prefix, the current directory, and the changed
+// files to the repository root. With --follow the single path may have had
+// another name in older commits, so every returned commit is credited to it.
+func (f *commitFilters) markTouchedPaths(commits []models.Commit, prefix string) {
+	for i := range commits {
+		if f.Follow {
+			commits[i].Paths = f.Paths
+			continue
+		}
+		for _, pathspec := range f.Paths {
+			for _, file := range commits[i].Files {
+				if gitexec.MatchPathspec(gitexec.ResolvePathspec(pathspec, prefix), file) {
+					commits[i].Paths = append(commits[i].Paths, pathspec)
+					break
+				}
+			}
+		}
+	}
+}
+
+func hasSymmetricRange(revisions []string) bool {
+	for _, revision := range revisions {
+		if strings.Contains(revision, "...") {
+			return true
+		}
+	}
+	return false
+}
+
+func gitDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func resolvedDate(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.Format(time.RFC3339)
+}
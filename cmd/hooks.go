@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/hooks"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "Install and manage git hooks that run glo",
+	Long: `Manage git hooks that run glo subcommands.
+
+Hooks are written to the repository's hooks directory, .git/hooks or
+core.hooksPath when it is set. A hook that is already there is kept: it is
+renamed to <hook>` + hooks.ChainedSuffix + ` and runs first, and the glo check
+only runs when it succeeds. Removing the glo hook puts it back.
+
+Available hooks:
+  commit-msg   check each new commit message with glo lint
+  pre-push     check the messages of pushed commits with glo lint
+
+Without a subcommand, lists the installed hooks.
+
+Examples:
+  glo hooks                                  # List installed hooks
+  glo hooks install                          # Install every glo hook
+  glo hooks install commit-msg               # Install one hook
+  glo hooks remove pre-push                  # Remove a glo hook`,
+	Args: cobra.NoArgs,
+	Run:  runHooksListCommand,
+}
+
+var hooksInstallCmd = &cobra.Command{
+	Use:   "install [hook...]",
+	Short: "Install glo hooks, chaining any existing hook",
+	Run:   runHooksInstallCommand,
+}
+
+var hooksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List installed hooks and the glo hooks available",
+	Args:  cobra.NoArgs,
+	Run:   runHooksListCommand,
+}
+
+var hooksRemoveCmd = &cobra.Command{
+	Use:   "remove [hook...]",
+	Short: "Remove glo hooks, restoring any hook they chained",
+	Run:   runHooksRemoveCommand,
+}
+
+func runHooksInstallCommand(cmd *cobra.Command, args []string) {
+	dir := hooksDir()
+
+	var definitions []hooks.Definition
+	if len(args) == 0 {
+		definitions = hooks.Definitions
+	}
+	for _, name := range args {
+		definition, ok := hooks.Lookup(name)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: glo has no %s hook. Available: %s\n", name, strings.Join(hookNames(), ", "))
+			os.Exit(1)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	for _, definition := range definitions {
+		chained, err := hooks.Install(dir, definition)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error installing %s: %v\n", definition.Name, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Installed %s: %s\n", definition.Name, definition.Description)
+		if chained {
+			fmt.Printf("  The existing hook was kept as %s and runs first.\n", definition.Name+hooks.ChainedSuffix)
+		}
+	}
+}
+
+func runHooksRemoveCommand(cmd *cobra.Command, args []string) {
+	dir := hooksDir()
+
+	names := args
+	if len(names) == 0 {
+		installed, err := hooks.List(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		for _, hook := range installed {
+			if hook.Managed {
+				names = append(names, hook.Name)
+			}
+		}
+		if len(names) == 0 {
+			fmt.Println("No glo hooks are installed.")
+			return
+		}
+	}
+
+	for _, name := range names {
+		restored, err := hooks.Remove(dir, name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Removed %s\n", name)
+		if restored {
+			fmt.Printf("  Restored the hook it chained.\n")
+		}
+	}
+}
+
+func runHooksListCommand(cmd *cobra.Command, args []string) {
+	format, _ := cmd.Flags().GetString("format")
+	dir := hooksDir()
+
+	installed, err := hooks.List(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	switch strings.ToLower(format) {
+	case "json":
+		if installed == nil {
+			installed = []models.Hook{}
+		}
+		data, err := json.MarshalIndent(map[string]interface{}{
+			"directory": dir,
+			"hooks":     installed,
+		}, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	case "color", "":
+		displayHooks(dir, installed)
+	default:
+		fmt.Fprintf(os.Stderr, "Error: Unknown format '%s'. Use: color or json\n", format)
+		os.Exit(1)
+	}
+}
+
+func displayHooks(dir string, installed []models.Hook) {
+	colorFormatter := formatter.NewColorFormatter()
+
+	fmt.Println(colorFormatter.FormatHeader("Git Hooks"))
+	fmt.Printf("Directory: %s\n\n", dir)
+
+	if len(installed) == 0 {
+		fmt.Println("No hooks installed.")
+	}
+	present := make(map[string]bool)
+	for _, hook := range installed {
+		present[hook.Name] = true
+		if hook.Managed {
+			fmt.Printf("  %s%-20s%s glo: %s", formatter.ColorGreen, hook.Name, formatter.ColorReset, hook.Description)
+		} else {
+			fmt.Printf("  %s%-20s%s not managed by glo", formatter.ColorYellow, hook.Name, formatter.ColorReset)
+		}
+		if hook.Chained {
+			fmt.Printf(" (runs %s first)", hook.Name+hooks.ChainedSuffix)
+		}
+		fmt.Println()
+	}
+
+	var available []string
+	for _, definition := range hooks.Definitions {
+		if !present[definition.Name] {
+			available = append(available, definition.Name)
+		}
+	}
+	if len(available) > 0 {
+		fmt.Printf("\nNot installed: %s (glo hooks install)\n", strings.Join(available, ", "))
+	}
+}
+
+// hooksDir returns the hooks directory of the current repository or exits.
+func hooksDir() string {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	dir, err := gitExec.GetHooksDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	return filepath.Clean(dir)
+}
+
+func hookNames() []string {
+	names := make([]string, len(hooks.Definitions))
+	for i, definition := range hooks.Definitions {
+		names[i] = definition.Name
+	}
+	return names
+}
+
+func init() {
+	rootCmd.AddCommand(hooksCmd)
+	hooksCmd.AddCommand(hooksInstallCmd)
+	hooksCmd.AddCommand(hooksListCmd)
+	hooksCmd.AddCommand(hooksRemoveCmd)
+
+	hooksCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+	hooksListCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/issues"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/DinethDilhara/glo/internal/references"
+	"github.com/spf13/cobra"
+)
+
+var issuesCmd = &cobra.Command{
+	Use:   "issues [<revision-range>...] [-- <path>...]",
+	Short: "Group the commits of a range by the issues they reference",
+	Long: `List the issue-tracker keys referenced across a range of commits, with the
+commits under each key and the commits that reference no issue at all.
+
+Keys are read from commit subjects and bodies, and from the branch names in
+merge commit subjects ("Merge branch 'PROJ-12-login'", "Merge pull request
+#34 from org/PROJ-12-login"), which credit the key to the merge and to every
+commit it brought in. Keys match Jira-style PROJ-123 by default; set
+"references.issue_patterns" and "references.issue_url" in .glo.json to
+match your tracker and link its issues (see glo log --help).
+
+It accepts the same filters as glo log, including --where.
+
+Output formats:
+- color (default): Issues with their commits
+- markdown: A section with a commit table per issue
+- json: Full report
+- csv: One row per issue and commit
+
+Examples:
+  glo issues v1.2.0..v1.3.0                  # Tickets that went into a release
+  glo issues v1.2.0..HEAD --no-merges        # Without the merge commits
+  glo issues --since="this sprint"           # Tickets worked on this sprint
+  glo issues v1.2.0..v1.3.0 -f csv > rel.csv # Export for a spreadsheet`,
+	Run: runIssuesCommand,
+}
+
+func runIssuesCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+
+	filters, err := readCommitFilters(cmd, args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	commits, err := filters.FetchCommits(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching git logs: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Merges are read from the whole range, so that branch names still
+	// count when --no-merges, --where or --limit leave the merges out.
+	mergeFilters := &commitFilters{Revisions: filters.Revisions, All: filters.All, Merges: true}
+	merges, err := mergeFilters.FetchCommits(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching merge commits: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := addBranchIssues(gitExec, merges, commits); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := issues.Build(commits)
+	issuesFormatter := formatters.NewIssuesFormatter(format == "color")
+
+	var output string
+	switch strings.ToLower(format) {
+	case "json":
+		output, err = issuesFormatter.FormatJSON(report)
+		output += "\n"
+	case "markdown", "md":
+		output = issuesFormatter.FormatMarkdown(report)
+	case "csv":
+		output, err = issuesFormatter.FormatCSV(report)
+	case "color", "":
+		output = issuesFormatter.FormatColor(report)
+	default:
+		err = fmt.Errorf("unknown format '%s'. Use: color, markdown, json, or csv", format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+}
+
+// addBranchIssues credits the issue keys in the branch name of each merge
+// to the commits that merge brought in, where they are in commits.
+func addBranchIssues(gitExec *gitexec.GitExecutor, merges, commits []models.Commit) error {
+	extractor, err := loadReferenceExtractor(gitExec)
+	if err != nil {
+		return err
+	}
+
+	index := make(map[string]int, len(commits))
+	for i, commit := range commits {
+		index[commit.Hash] = i
+	}
+
+	for _, commit := range merges {
+		branchIssues := extractor.Issues(references.MergedBranch(commit.Message))
+		if len(branchIssues) == 0 {
+			continue
+		}
+
+		merged, err := gitExec.GetMergedCommits(commit)
+		if err != nil {
+			return fmt.Errorf("error reading commits merged by %s: %v", commit.Hash[:8], err)
+		}
+		for _, mergedCommit := range merged {
+			if i, ok := index[mergedCommit.Hash]; ok {
+				commits[i].References = references.Append(commits[i].References, branchIssues...)
+			}
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(issuesCmd)
+
+	addCommitFilterFlags(issuesCmd)
+	issuesCmd.Flags().StringP("format", "f", "color", "Output format: color, markdown, json, csv")
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/lint"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [<revision-range>...] [-- <path>...]",
+	Short: "Check commit messages against the team's conventions",
+	Long: `Check commit messages against a set of rules and report every violation.
+
+Without a range, the commits of the current branch that are not on the
+default branch are checked, or only HEAD on the default branch itself.
+Merge commits are skipped. It accepts the same filters as glo log.
+
+Rules (default rules are marked *):
+  conventional         Subject is type(scope): description, with a known type
+  subject-length     * Subject is present and at most 72 characters
+  blank-line         * The second line is blank
+  imperative         * Subject starts with "Add", not "Added" or "Adds" (warning)
+  wip                * No WIP commits
+  fixup              * No fixup!, squash! or amend! commits
+  required-trailers    Configured trailers such as Signed-off-by are present
+
+Rules are configured under "lint" in .glo.json:
+
+  {"lint": {"enable": ["conventional"], "disable": ["imperative"],
+            "max_subject_length": 60, "types": ["feat", "fix", "docs"],
+            "required_trailers": ["Signed-off-by"]}}
+
+The command exits with status 1 when any error-level rule is broken, so it
+can gate CI. --install-hook installs it as the repository's commit-msg hook,
+which checks each message as it is committed with --file; it is the same as
+glo hooks install commit-msg.
+
+Output formats:
+- color (default): Violations per commit
+- json: Full report
+- sarif: SARIF 2.1.0 log for code scanning tools
+
+Examples:
+  glo lint                                   # Commits on this branch
+  glo lint origin/main..HEAD                 # Commits of a pull request
+  glo lint --enable=conventional             # Also require Conventional Commits
+  glo lint --disable=imperative              # Skip the mood heuristic
+  glo lint -f sarif > lint.sarif             # Upload to code scanning
+  glo lint --install-hook                    # Check every new commit message
+  glo lint --file=.git/COMMIT_EDITMSG        # Check a message before committing`,
+	Run: runLintCommand,
+}
+
+func runLintCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	file, _ := cmd.Flags().GetString("file")
+	enable, _ := cmd.Flags().GetStringSlice("enable")
+	disable, _ := cmd.Flags().GetStringSlice("disable")
+	installHook, _ := cmd.Flags().GetBool("install-hook")
+
+	if installHook {
+		runHooksInstallCommand(cmd, []string{"commit-msg"})
+		return
+	}
+
+	cfg, err := loadConfig(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading config: %v\n", err)
+		os.Exit(1)
+	}
+	linter, err := lint.New(cfg.Lint, enable, disable)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := &models.LintReport{Rules: linter.ActiveRules()}
+	if file != "" {
+		err = lintMessageFile(gitExec, linter, file, report)
+	} else {
+		err = lintCommits(cmd, args, gitExec, linter, report)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// As a hook, stay quiet unless something is wrong.
+	if file != "" && format == "color" && len(report.Results) == 0 {
+		return
+	}
+
+	lintFormatter := formatters.NewLintFormatter(format == "color")
+
+	var output string
+	switch strings.ToLower(format) {
+	case "json":
+		output, err = lintFormatter.FormatJSON(report)
+		output += "\n"
+	case "sarif":
+		output, err = lintFormatter.FormatSARIF(report)
+		output += "\n"
+	case "color", "":
+		output = lintFormatter.FormatColor(report)
+	default:
+		err = fmt.Errorf("unknown format '%s'. Use: color, json, or sarif", format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+	if report.Errors > 0 {
+		os.Exit(1)
+	}
+}
+
+func lintCommits(cmd *cobra.Command, args []string, gitExec *gitexec.GitExecutor, linter *lint.Linter, report *models.LintReport) error {
+	filters, err := readCommitFilters(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(filters.Revisions) == 0 && !filters.All {
+		filters.Revisions = defaultLintRange(gitExec)
+		if len(filters.Revisions) == 0 && filters.Limit == 0 {
+			filters.Limit = 1
+		}
+	}
+
+	commits, err := filters.FetchCommits(gitExec)
+	if err != nil {
+		return fmt.Errorf("error fetching git logs: %v", err)
+	}
+
+	var hashes []string
+	for _, commit := range commits {
+		if !commit.IsMerge() {
+			hashes = append(hashes, commit.Hash)
+		}
+	}
+	messages, err := gitExec.GetRawMessages(hashes)
+	if err != nil {
+		return fmt.Errorf("error reading commit messages: %v", err)
+	}
+
+	for _, commit := range commits {
+		if commit.IsMerge() {
+			continue
+		}
+		report.Add(models.LintResult{
+			Hash:       commit.Hash,
+			Subject:    commit.Message,
+			Author:     commit.Author,
+			Violations: linter.Lint(lint.Message{Raw: messages[commit.Hash], Trailers: commit.Trailers}),
+		})
+	}
+	return nil
+}
+
+// defaultLintRange selects the commits of the current branch that are not
+// on the default branch. It returns nil on the default branch itself or
+// when there is none.
+func defaultLintRange(gitExec *gitexec.GitExecutor) []string {
+	defaultBranch, err := gitExec.GetDefaultBranch()
+	if err != nil || defaultBranch == "" {
+		return nil
+	}
+	current, err := gitExec.GetCurrentBranch()
+	if err != nil || current == defaultBranch {
+		return nil
+	}
+	return []string{defaultBranch + "..HEAD"}
+}
+
+// lintMessageFile checks a message that is being committed, as the
+// commit-msg hook receives it.
+func lintMessageFile(gitExec *gitexec.GitExecutor, linter *lint.Linter, path string, report *models.LintReport) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	trailers, err := gitExec.GetFileTrailers(path)
+	if err != nil {
+		return fmt.Errorf("error reading trailers: %v", err)
+	}
+
+	raw, err := gitExec.CleanMessage(string(data))
+	if err != nil {
+		return fmt.Errorf("error cleaning up the message: %v", err)
+	}
+
+	message := lint.Message{Raw: raw, Trailers: trailers}
+	report.Add(models.LintResult{
+		Subject:    message.Subject(),
+		Violations: linter.Lint(message),
+	})
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	addCommitFilterFlags(lintCmd)
+	lintCmd.Flags().StringP("format", "f", "color", "Output format: color, json, sarif")
+	lintCmd.Flags().String("file", "", "Check the commit message in this file instead of commits (commit-msg hook)")
+	lintCmd.Flags().StringSlice("enable", nil, "Also run these rules")
+	lintCmd.Flags().StringSlice("disable", nil, "Do not run these rules")
+	lintCmd.Flags().Bool("install-hook", false, "Install glo lint as the repository's commit-msg hook")
+}
@@ -6,13 +6,14 @@ import (
 	"strings"
 
 	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/formatters"
 	"github.com/DinethDilhara/glo/internal/gitexec"
 	"github.com/DinethDilhara/glo/internal/models"
 	"github.com/spf13/cobra"
 )
 
 var logCmd = &cobra.Command{
-	Use:   "log",
+	Use:   "log [<revision-range>...] [-- <path>...]",
 	Short: "Show git commit history with various formatting options",
 	Long: `Display git commit history with filtering and formatting capabilities.
 
@@ -25,12 +26,77 @@ The log command allows you to:
 Examples:
   glo log                                    # Show recent commits
   glo log --author="John Doe"                # Filter by author
+  glo log --author="Jane" --co-authors       # Include Co-authored-by credits
   glo log --since="2024-01-01"               # Show commits since date
   glo log --until="2024-12-31"               # Show commits until date
+  glo log --since="last monday"              # Natural-language dates
+  glo log --since="2 weeks ago"              # Relative dates
+  glo log --since="Q3 2025" --until="Q3 2025" # A whole quarter
+  glo log --since="this sprint"              # The current sprint, as configured
   glo log --message="fix"                    # Search in commit messages
   glo log --limit=10                         # Limit to 10 commits
+  glo log --where='author ~ "alice|bob" and not merge'
+  glo log --where='path = src/ and date >= "2 weeks ago"'
+  glo log --where='trailer:co-authored-by or branch = release'
   glo log --format=json                      # Output as JSON
-  glo log --format=markdown --table          # Output as markdown table`,
+  glo log --format=markdown --table          # Output as markdown table
+  glo log -- src/ docs/                      # Only commits touching these paths
+  glo log --follow -- cmd/log.go             # Follow a file across renames
+  glo log main..feature                      # Commits feature adds to main
+  glo log --left-right main...feature        # Both sides, marked < and >
+  glo log develop release/1.2                # Commits reachable from either
+  glo log --all                              # Commits from every ref
+  glo log --merges                           # Only merge commits
+  glo log --no-merges                        # Leave out merge commits
+  glo log --first-parent main                # The history of main itself
+  glo log --prs                              # Each merge with the commits it brought in
+  glo log --pr=1234                          # Commits referencing pull request #1234
+  glo log --unsigned origin/main..HEAD       # Commits without a good signature
+  glo log --signed-only --summary            # Only verified commits
+  glo log --signatures                       # Mark signed commits
+
+"this sprint" and "last sprint" need the team's sprint cadence in .glo.json:
+
+  {"sprint": {"start": "2024-01-01", "days": 14}}
+
+The --where expression combines predicates on author, email, message,
+subject, body, path, date, merge, branch (containment) and trailer:<key>
+with and, or, not and parentheses. Operators are = and != (case-insensitive),
+~ and !~ (regular expressions) and <, <=, >, >= for dates. Parts that git
+can evaluate are passed to git log; the rest is applied afterwards, and
+--limit always counts commits after every filter.
+
+Merge commits are marked [merge] and carry "merge": true in JSON. --prs
+shows the pull request view: the first-parent history of the branch, with
+the commits each merge brought in listed under it. Filters and --limit apply
+to the first-parent commits, not to the merged ones.
+
+Pull request references ("(#1234)", "Merge pull request #1234", GitLab's
+"See merge request group/app!1234") and Jira-style issue keys (PROJ-123) are
+read from each message into "references" in JSON and listed, as links when
+URL templates are configured, in markdown. Both can be changed in .glo.json:
+
+  {"references": {"issue_patterns": ["\\b(OPS-\\d+)\\b"],
+                  "pull_request_url": "https://github.com/org/repo/pull/{id}",
+                  "issue_url": "https://jira.example.com/browse/{id}"}}
+
+Signed commits are verified against the local keyrings (GPG, or the
+gpg.ssh.allowedSignersFile for SSH signatures), nothing is fetched. They
+are marked [signed] or with what is wrong with the signature, such as
+[bad signature] or [signed, expired key], and JSON has a "signature" object
+with the status, signer and key. --signed-only keeps commits with a good
+signature from a trusted key; --unsigned keeps the rest, including bad,
+untrusted and unverifiable signatures. Verifying signatures is slow, so it
+is only done with --signatures, --signed-only or --unsigned; summaries then
+count the unsigned commits.
+
+Authors are shown as mapped by .mailmap and by the aliases in .glo.json or
+the user config (~/.config/glo/config.json), and summaries count each
+canonical identity once. JSON output keeps the recorded identity in
+raw_author and raw_author_email. Example .glo.json:
+
+  {"aliases": [{"name": "Jane Doe", "email": "jane@example.com",
+                "match": ["jdoe@old-laptop.local", "J. Doe"]}]}`,
 	Run: runLogCommand,
 }
 
@@ -41,27 +107,33 @@ func runLogCommand(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	author, _ := cmd.Flags().GetString("author")
-	since, _ := cmd.Flags().GetString("since")
-	until, _ := cmd.Flags().GetString("until")
-	message, _ := cmd.Flags().GetString("message")
-	limit, _ := cmd.Flags().GetInt("limit")
 	format, _ := cmd.Flags().GetString("format")
 	table, _ := cmd.Flags().GetBool("table")
 	summary, _ := cmd.Flags().GetBool("summary")
+	prView, _ := cmd.Flags().GetBool("prs")
 	
 	if format == "" {
 		format, _ = cmd.Parent().PersistentFlags().GetString("format")
 	}
 
-	commits, err := gitExec.GetGitLogs(author, since, until, limit)
+	filters, err := readCommitFilters(cmd, args)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error fetching git logs: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
-	if message != "" {
-		commits = filterCommitsByMessage(commits, message)
+	if prView {
+		if filters.NoMerges || summary {
+			fmt.Fprintf(os.Stderr, "Error: --prs cannot be combined with --no-merges or --summary\n")
+			os.Exit(1)
+		}
+		filters.FirstParent = true
+	}
+
+	commits, err := filters.FetchCommits(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching git logs: %v\n", err)
+		os.Exit(1)
 	}
 
 	if len(commits) == 0 {
@@ -69,23 +141,34 @@ func runLogCommand(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	if prView {
+		displayMergeGroups(gitExec, commits, format)
+		return
+	}
+
 	switch strings.ToLower(format) {
 	case "json":
 		jsonFormatter := formatter.NewJSONFormatter(true)
 		if summary {
 			metadata := map[string]interface{}{
-				"author": author,
-				"since":  since,
-				"until":  until,
+				"revisions":      filters.Revisions,
+				"author":         filters.Author,
+				"since":          filters.Since,
+				"until":          filters.Until,
+				"where":          filters.Where,
+				"co_authors":     filters.CoAuthors,
+				"paths":          filters.Paths,
+				"since_resolved": resolvedDate(filters.SinceTime),
+				"until_resolved": resolvedDate(filters.UntilTime),
 			}
-			fmt.Println(jsonFormatter.FormatSummary(commits, metadata))
+			fmt.Println(jsonFormatter.FormatSummary(commits, metadata, filters.SummaryOptions()))
 		} else {
 			fmt.Println(jsonFormatter.FormatList(commits))
 		}
 	case "markdown", "md":
 		mdFormatter := formatter.NewMarkdownFormatter()
 		if summary {
-			fmt.Println(mdFormatter.FormatSummary(commits))
+			fmt.Println(mdFormatter.FormatSummary(commits, filters.SummaryOptions()))
 		} else if table {
 			fmt.Println(mdFormatter.FormatTable(commits))
 		} else {
@@ -94,7 +177,7 @@ func runLogCommand(cmd *cobra.Command, args []string) {
 	case "color", "":
 		colorFormatter := formatter.NewColorFormatter()
 		if summary {
-			displayColorSummary(commits, colorFormatter)
+			displayColorSummary(commits, colorFormatter, filters.SummaryOptions())
 		} else {
 			fmt.Println(colorFormatter.FormatList(commits))
 		}
@@ -117,18 +200,89 @@ func filterCommitsByMessage(commits []models.Commit, message string) []models.Co
 	return filtered
 }
 
-func displayColorSummary(commits []models.Commit, colorFormatter *formatter.ColorFormatter) {
+// displayMergeGroups prints the pull request view of first-parent commits:
+// each merge followed by the commits it brought in.
+func displayMergeGroups(gitExec *gitexec.GitExecutor, commits []models.Commit, format string) {
+	resolver, err := loadIdentityResolver(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	extractor, err := loadReferenceExtractor(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	groups := make([]models.MergeGroup, len(commits))
+	for i, commit := range commits {
+		merged, err := gitExec.GetMergedCommits(commit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching commits merged by %s: %v\n", commit.Hash[:8], err)
+			os.Exit(1)
+		}
+		resolver.ApplyCommits(merged)
+		extractor.ApplyCommits(merged)
+		groups[i] = models.MergeGroup{Commit: commit, Merged: merged}
+	}
+
+	mergeFormatter := formatters.NewMergeGroupFormatter(true)
+
+	switch strings.ToLower(format) {
+	case "json":
+		output, err := mergeFormatter.FormatJSON(groups)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(output)
+	case "markdown", "md":
+		fmt.Print(mergeFormatter.FormatMarkdown(groups))
+	case "color", "":
+		fmt.Print(mergeFormatter.FormatColor(groups))
+	default:
+		fmt.Fprintf(os.Stderr, "Error: Unknown format '%s'. Use: color, json, or markdown\n", format)
+		os.Exit(1)
+	}
+}
+
+func displayColorSummary(commits []models.Commit, colorFormatter *formatter.ColorFormatter, options formatter.SummaryOptions) {
 	fmt.Println(colorFormatter.FormatHeader("Git Repository Summary"))
-	fmt.Printf("Total commits: %d\n\n", len(commits))
-	
-	authorCount := make(map[string]int)
-	for _, commit := range commits {
-		authorCount[commit.Author]++
+	if dateRange := formatter.FormatDateRange(options.Since, options.Until); dateRange != "" {
+		fmt.Printf("Date range: %s\n", dateRange)
+	}
+	if len(options.Paths) > 0 {
+		fmt.Printf("Paths: %s\n", strings.Join(options.Paths, ", "))
+	}
+	fmt.Printf("Total commits: %d\n", len(commits))
+	if merges := formatter.CountMerges(commits); merges > 0 {
+		fmt.Printf("Merge commits: %d\n", merges)
+	}
+	var unsigned []models.Commit
+	if options.Signatures {
+		unsigned = formatter.UnsignedCommits(commits)
+		fmt.Printf("Unsigned commits: %d of %d\n", len(unsigned), len(commits))
 	}
+	fmt.Println()
 	
 	fmt.Println(colorFormatter.FormatHeader("Commits by Author:"))
-	for author, count := range authorCount {
-		fmt.Printf("  %s: %d commits\n", author, count)
+	for _, author := range models.CountCommitsByAuthor(commits, options.CoAuthors).Ranked() {
+		fmt.Printf("  %s: %d commits\n", author.Author, author.Count)
+	}
+	
+	if len(unsigned) > 0 {
+		fmt.Println(colorFormatter.FormatHeader("Unsigned Commits by Author:"))
+		for _, author := range models.CountCommitsByAuthor(unsigned, false).Ranked() {
+			fmt.Printf("  %s: %d commits\n", author.Author, author.Count)
+		}
+	}
+	
+	if len(options.Paths) > 1 {
+		pathCount := formatter.CountByPath(commits, options.Paths)
+		fmt.Println(colorFormatter.FormatHeader("Commits by Path:"))
+		for _, path := range options.Paths {
+			fmt.Printf("  %s: %d commits\n", path, pathCount[path])
+		}
 	}
 	
 	fmt.Println(colorFormatter.FormatHeader("\nRecent Commits:"))
@@ -145,12 +299,9 @@ func displayColorSummary(commits []models.Commit, colorFormatter *formatter.Colo
 func init() {
 	rootCmd.AddCommand(logCmd)
 
-	logCmd.Flags().StringP("author", "a", "", "Filter commits by author name")
-	logCmd.Flags().StringP("since", "s", "", "Show commits since date (YYYY-MM-DD)")
-	logCmd.Flags().StringP("until", "u", "", "Show commits until date (YYYY-MM-DD)")
-	logCmd.Flags().StringP("message", "m", "", "Filter commits containing message")
-	logCmd.Flags().IntP("limit", "l", 0, "Limit number of commits (0 = no limit)")
+	addCommitFilterFlags(logCmd)
 	logCmd.Flags().StringP("format", "f", "", "Output format: color, json, markdown")
 	logCmd.Flags().BoolP("table", "t", false, "Output markdown as table format")
 	logCmd.Flags().BoolP("summary", "", false, "Show summary with statistics")
+	logCmd.Flags().Bool("prs", false, "Pull request view: first-parent history with the commits each merge brought in")
 }
\ No newline at end of file
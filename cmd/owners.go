@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/DinethDilhara/glo/internal/owners"
+	"github.com/spf13/cobra"
+)
+
+var ownersCmd = &cobra.Command{
+	Use:   "owners [path...]",
+	Short: "Show who owns each area of the codebase according to blame",
+	Long: `Aggregate git blame line counts per author for every directory.
+
+Each tracked text file under the given paths (the whole tree by default) is
+blamed in parallel and the lines are summed per author per directory. Files
+deeper than --depth levels below a path are rolled up into their ancestor.
+
+When the repository has a CODEOWNERS file (.github/, the root or docs/), or
+one is given with --codeowners, each directory is compared against it:
+- files without a CODEOWNERS entry are reported
+- stale owners are declared owners holding less than --stale-below percent
+  of the lines
+- missing owners are authors holding at least --missing-above percent of the
+  lines who are not declared
+Team owners (@org/team) cannot be resolved to authors and are never flagged.
+
+Output formats:
+- table (default): Directory table with top owners and CODEOWNERS findings
+- json: Full report
+- markdown: Markdown table
+
+Examples:
+  glo owners                           # Ownership of the whole tree
+  glo owners src --depth=2             # Two levels below src/
+  glo owners --rev=v1.0.0              # Ownership as of a release
+  glo owners --no-codeowners           # Skip the CODEOWNERS comparison
+  glo owners --format=markdown         # Markdown report`,
+	Run: runOwnersCommand,
+}
+
+func runOwnersCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	revision, _ := cmd.Flags().GetString("rev")
+	depth, _ := cmd.Flags().GetInt("depth")
+	workers, _ := cmd.Flags().GetInt("workers")
+	top, _ := cmd.Flags().GetInt("top")
+	codeOwnersPath, _ := cmd.Flags().GetString("codeowners")
+	noCodeOwners, _ := cmd.Flags().GetBool("no-codeowners")
+	staleBelow, _ := cmd.Flags().GetFloat64("stale-below")
+	missingAbove, _ := cmd.Flags().GetFloat64("missing-above")
+
+	opts := owners.Options{
+		Depth:        depth,
+		Bases:        args,
+		StaleBelow:   staleBelow,
+		MissingAbove: missingAbove,
+	}
+
+	if !noCodeOwners {
+		codeOwners, err := loadCodeOwners(gitExec, codeOwnersPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading CODEOWNERS: %v\n", err)
+			os.Exit(1)
+		}
+		opts.CodeOwners = codeOwners
+
+		opts.Prefix, err = gitExec.GetPathPrefix()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	files, err := gitExec.ListTextFiles(revision, args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error listing files: %v\n", err)
+		os.Exit(1)
+	}
+
+	resolver, err := loadIdentityResolver(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	blames, skipped := owners.Collect(files, workers, func(path string) (*models.BlameFile, error) {
+		blame, err := gitExec.GetBlame(path, revision)
+		if err == nil {
+			resolver.ApplyBlame(blame)
+		}
+		return blame, err
+	})
+	for _, file := range skipped {
+		fmt.Fprintf(os.Stderr, "Warning: skipped %s: %s\n", file.Path, file.Error)
+	}
+
+	report := owners.Build(blames, opts)
+	report.Revision = revision
+	report.Skipped = skipped
+
+	ownersFormatter := formatters.NewOwnersFormatter(format == "table", top)
+
+	var output string
+	switch strings.ToLower(format) {
+	case "json":
+		output, err = ownersFormatter.FormatJSON(report)
+		output += "\n"
+	case "markdown", "md":
+		output = ownersFormatter.FormatMarkdown(report)
+	case "table", "":
+		output = ownersFormatter.FormatTable(report)
+	default:
+		err = fmt.Errorf("unknown format '%s'. Use: table, json, or markdown", format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+}
+
+func loadCodeOwners(gitExec *gitexec.GitExecutor, path string) (*owners.CodeOwners, error) {
+	if path != "" {
+		return owners.LoadCodeOwners(path)
+	}
+
+	root, err := gitExec.GetRepositoryRoot()
+	if err != nil {
+		return nil, err
+	}
+	return owners.FindCodeOwners(root)
+}
+
+func init() {
+	rootCmd.AddCommand(ownersCmd)
+
+	ownersCmd.Flags().StringP("format", "f", "table", "Output format: table, json, markdown")
+	ownersCmd.Flags().StringP("rev", "r", "", "Report ownership as of this revision")
+	ownersCmd.Flags().IntP("depth", "d", 1, "Directory levels below each path to report separately")
+	ownersCmd.Flags().IntP("workers", "j", runtime.NumCPU(), "Number of files blamed in parallel")
+	ownersCmd.Flags().IntP("top", "t", 3, "Owners listed per directory in table and markdown output (0 = all)")
+	ownersCmd.Flags().String("codeowners", "", "CODEOWNERS file to compare against (default: detect)")
+	ownersCmd.Flags().Bool("no-codeowners", false, "Do not compare against CODEOWNERS")
+	ownersCmd.Flags().Float64("stale-below", 5, "Flag declared owners holding less than this percent of lines")
+	ownersCmd.Flags().Float64("missing-above", 25, "Flag undeclared authors holding at least this percent of lines")
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/dateparse"
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var reflogCmd = &cobra.Command{
+	Use:   "reflog [ref]",
+	Short: "Explore the reflog to find and recover lost work",
+	Long: `List the updates recorded in the reflog of a ref, HEAD by default, newest
+first. Each entry shows where the ref pointed afterwards and the action that
+moved it, colored by action: commit, rebase, reset, checkout, merge or pull.
+
+Entries are named as git names them, HEAD@{n} or main@{n}, and keep their
+name when filtered. After a rebase or reset that went wrong, find the last
+entry from before it and run glo reflog recover with its name: it prints the
+git commands that restore that state as a new branch. Nothing is changed.
+
+Output formats:
+- color (default): One line per entry
+- json: All entries with old and new hashes
+- markdown: Markdown table
+
+Examples:
+  glo reflog                                 # Recent moves of HEAD
+  glo reflog main                            # Updates of the main branch
+  glo reflog --action=rebase,reset           # Only rebases and resets
+  glo reflog --since="yesterday"             # What happened since yesterday
+  glo reflog recover HEAD@{4}                # Restore an entry as a new branch
+  glo reflog recover 4 before-rebase         # The same, naming the branch`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runReflogCommand,
+}
+
+var reflogRecoverCmd = &cobra.Command{
+	Use:   "recover <entry> [branch]",
+	Short: "Print the commands that restore a reflog entry as a new branch",
+	Long: `Print the commands that create a branch at the commit a reflog entry
+points to and switch to it. The entry is a name such as HEAD@{4} or
+main@{2}, or a number for an entry of HEAD. The branch is called
+recovered-<hash> unless named.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run:  runReflogRecoverCommand,
+}
+
+var reflogSelector = regexp.MustCompile(`^(.+)@\{(\d+)\}$`)
+
+func runReflogCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	actions, _ := cmd.Flags().GetStringSlice("action")
+	since, _ := cmd.Flags().GetString("since")
+	until, _ := cmd.Flags().GetString("until")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	sprint, err := loadSprint(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading config: %v\n", err)
+		os.Exit(1)
+	}
+	sinceTime, untilTime, err := dateparse.ResolveSinceUntil(since, until, time.Now(), sprint)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	ref := "HEAD"
+	if len(args) == 1 {
+		ref = args[0]
+	}
+	reflog, err := gitExec.GetReflog(ref)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	reflog.Entries = filterReflogEntries(reflog.Entries, actions, sinceTime, untilTime)
+	if limit > 0 && len(reflog.Entries) > limit {
+		reflog.Entries = reflog.Entries[:limit]
+	}
+
+	reflogFormatter := formatters.NewReflogFormatter(format == "color")
+
+	var output string
+	switch strings.ToLower(format) {
+	case "json":
+		output, err = reflogFormatter.FormatJSON(reflog)
+		output += "\n"
+	case "markdown", "md":
+		output = reflogFormatter.FormatMarkdown(reflog)
+	case "color", "":
+		output = reflogFormatter.FormatColor(reflog)
+	default:
+		err = fmt.Errorf("unknown format '%s'. Use: color, json, or markdown", format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+}
+
+func runReflogRecoverCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	ref, index, err := parseReflogEntry(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	reflog, err := gitExec.GetReflog(ref)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if index >= len(reflog.Entries) {
+		fmt.Fprintf(os.Stderr, "Error: %s has only %d reflog entries\n", ref, len(reflog.Entries))
+		os.Exit(1)
+	}
+	entry := reflog.Entries[index]
+
+	if !gitExec.CommitExists(entry.NewHash) {
+		fmt.Fprintf(os.Stderr, "Error: commit %s of %s was garbage collected and cannot be recovered\n", entry.NewHash[:8], entry.Selector)
+		os.Exit(1)
+	}
+
+	branch := "recovered-" + entry.NewHash[:8]
+	if len(args) == 2 {
+		branch = args[1]
+	}
+	if gitExec.BranchExists(branch) {
+		fmt.Fprintf(os.Stderr, "Error: branch '%s' already exists; name another: glo reflog recover %s <branch>\n", branch, args[0])
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s: %s\n", entry.Selector, entry.Message)
+	commits, err := gitExec.GetGitLogs(gitexec.LogOptions{Revisions: []string{entry.NewHash}, MaxCount: 1})
+	if err == nil && len(commits) == 1 {
+		fmt.Printf("  Commit %s by %s: %s\n", entry.NewHash[:8], commits[0].Author, commits[0].Message)
+	}
+	if ahead, _, err := gitExec.GetAheadBehind("HEAD", entry.NewHash); err == nil && ahead > 0 {
+		fmt.Printf("  It has %d commits that HEAD does not (git log HEAD..%s).\n", ahead, entry.NewHash[:8])
+	}
+
+	fmt.Printf("\nTo restore it as a new branch, run:\n\n")
+	fmt.Printf("  git branch %s %s\n", branch, entry.NewHash)
+	fmt.Printf("  git switch %s\n", branch)
+}
+
+// parseReflogEntry reads an entry name such as HEAD@{4} or main@{2}. A bare
+// number is an entry of HEAD.
+func parseReflogEntry(name string) (string, int, error) {
+	ref, number := "HEAD", name
+	if match := reflogSelector.FindStringSubmatch(name); match != nil {
+		ref, number = match[1], match[2]
+	}
+	index, err := strconv.Atoi(number)
+	if err != nil || index < 0 {
+		return "", 0, fmt.Errorf("invalid reflog entry '%s', expected e.g. HEAD@{4} or 4", name)
+	}
+	return ref, index, nil
+}
+
+// filterReflogEntries keeps the entries of the given actions, any action
+// when none are given, recorded between since and until.
+func filterReflogEntries(entries []models.ReflogEntry, actions []string, since, until time.Time) []models.ReflogEntry {
+	for i := range actions {
+		actions[i] = strings.ToLower(strings.TrimSpace(actions[i]))
+	}
+
+	var filtered []models.ReflogEntry
+	for _, entry := range entries {
+		if len(actions) > 0 && !slices.Contains(actions, strings.ToLower(entry.Action)) {
+			continue
+		}
+		if !since.IsZero() && entry.Date.Before(since) {
+			continue
+		}
+		if !until.IsZero() && entry.Date.After(until) {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+	return filtered
+}
+
+func init() {
+	rootCmd.AddCommand(reflogCmd)
+	reflogCmd.AddCommand(reflogRecoverCmd)
+
+	reflogCmd.Flags().StringP("format", "f", "color", "Output format: color, json, markdown")
+	reflogCmd.Flags().StringSlice("action", nil, "Only show these actions, e.g. commit, rebase, reset, checkout")
+	reflogCmd.Flags().StringP("since", "s", "", "Show entries since date (same forms as glo log --since)")
+	reflogCmd.Flags().StringP("until", "u", "", "Show entries until date (same forms as glo log --until)")
+	reflogCmd.Flags().IntP("limit", "l", 0, "Limit number of entries (0 = no limit)")
+}
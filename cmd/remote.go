@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/spf13/cobra"
+)
+
+var remoteCmd = &cobra.Command{
+	Use:   "remote",
+	Short: "List remotes with their URLs and branch counts",
+	Long: `Display the configured remotes of the repository.
+
+For every remote this shows the fetch URL (and the push URL when it
+differs), the branch its HEAD points to, how many remote-tracking branches
+it has and how many local branches track one of them.
+
+Examples:
+  glo remote                           # List remotes
+  glo remote --format=json             # Export as JSON`,
+	Args: cobra.NoArgs,
+	Run:  runRemoteCommand,
+}
+
+func runRemoteCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+
+	remotes, err := gitExec.GetRemotes()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading remotes: %v\n", err)
+		os.Exit(1)
+	}
+
+	remoteFormatter := formatters.NewRemoteFormatter(format == "color")
+
+	switch strings.ToLower(format) {
+	case "json":
+		output, err := remoteFormatter.FormatJSON(remotes)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(output)
+	case "color", "":
+		fmt.Print(remoteFormatter.FormatColor(remotes))
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown format '%s'. Use: color or json\n", format)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(remoteCmd)
+
+	remoteCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+}
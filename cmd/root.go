@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/DinethDilhara/glo/internal/formatter"
 	"github.com/spf13/cobra"
 )
 
@@ -34,8 +35,16 @@ Examples:
   glo log --author="John Doe"          # Filter by author
   glo log --since="2024-01-01"         # Show commits since date
   glo log --format=json                # Export as JSON
-  glo log --format=markdown            # Export as Markdown`,
+  glo log --format=markdown            # Export as Markdown
+  glo log --date=relative              # Show dates like "2 days ago"
+  glo log --tz=UTC                     # Show dates in UTC`,
 	Version: version,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		dateStyle, _ := cmd.Flags().GetString("date")
+		dateFormat, _ := cmd.Flags().GetString("date-format")
+		tz, _ := cmd.Flags().GetString("tz")
+		return formatter.SetDateOptions(dateStyle, dateFormat, tz)
+	},
 }
 
 
@@ -62,4 +71,7 @@ func init() {
 	
 	rootCmd.PersistentFlags().StringP("format", "f", "color", "Output format: color, json, markdown")
 	rootCmd.PersistentFlags().Bool("verbose", false, "Enable verbose output")
+	rootCmd.PersistentFlags().String("date", "iso", "Date style: relative, iso, short, local, rfc3339, custom")
+	rootCmd.PersistentFlags().String("date-format", "", "strftime-style layout for --date=custom (e.g. \"%Y-%m-%d %H:%M\")")
+	rootCmd.PersistentFlags().String("tz", "", "Time zone for dates: local, utc, an IANA name or an offset like +0530")
 }
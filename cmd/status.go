@@ -6,6 +6,7 @@ import (
 
 	"github.com/DinethDilhara/glo/internal/formatters"
 	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/hooks"
 	"github.com/spf13/cobra"
 )
 
@@ -48,6 +49,10 @@ func runStatus(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 	
+	if dir, err := gitExec.GetHooksDir(); err == nil {
+		status.Hooks, _ = hooks.List(dir)
+	}
+	
 	useColor := format == "color"
 	statusFormatter := formatters.NewStatusFormatter(useColor)
 	
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/spf13/cobra"
+)
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags [pattern...]",
+	Short: "List tags with their signature status",
+	Long: `List the tags of the repository, newest first, with the commit each points
+to and whether it is signed.
+
+Signed tags are verified with git verify-tag against the local keyrings
+(GPG, or the gpg.ssh.allowedSignersFile for SSH signatures), the same way
+glo log --signatures verifies commits, and are marked signed or with what
+is wrong with the signature. Lightweight tags cannot be signed. Patterns
+are matched like git for-each-ref patterns, e.g. "v1.*".
+
+Output formats:
+- color (default): One line per tag
+- json: All tags with their signatures
+
+Examples:
+  glo tags                                   # All tags
+  glo tags "v2.*"                            # Release tags of v2
+  glo tags --unsigned                        # Tags without a good signature`,
+	Run: runTagsCommand,
+}
+
+func runTagsCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	signedOnly, _ := cmd.Flags().GetBool("signed-only")
+	unsigned, _ := cmd.Flags().GetBool("unsigned")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	if signedOnly && unsigned {
+		fmt.Fprintf(os.Stderr, "Error: --signed-only and --unsigned cannot be combined\n")
+		os.Exit(1)
+	}
+
+	tags, err := gitExec.GetTags(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading tags: %v\n", err)
+		os.Exit(1)
+	}
+
+	if signedOnly || unsigned {
+		var filtered []models.Tag
+		for _, tag := range tags {
+			if tag.HasValidSignature() == signedOnly {
+				filtered = append(filtered, tag)
+			}
+		}
+		tags = filtered
+	}
+	if limit > 0 && len(tags) > limit {
+		tags = tags[:limit]
+	}
+
+	tagsFormatter := formatters.NewTagsFormatter(format == "color")
+
+	switch strings.ToLower(format) {
+	case "json":
+		output, err := tagsFormatter.FormatJSON(tags)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(output)
+	case "color", "":
+		fmt.Print(tagsFormatter.FormatColor(tags))
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown format '%s'. Use: color or json\n", format)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(tagsCmd)
+
+	tagsCmd.Flags().StringP("format", "f", "color", "Output format: color, json")
+	tagsCmd.Flags().Bool("signed-only", false, "Only show tags with a good signature from a trusted key")
+	tagsCmd.Flags().Bool("unsigned", false, "Only show tags without a good signature")
+	tagsCmd.Flags().IntP("limit", "l", 0, "Limit number of tags (0 = no limit)")
+}
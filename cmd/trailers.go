@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatters"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/trailers"
+	"github.com/spf13/cobra"
+)
+
+var trailersCmd = &cobra.Command{
+	Use:   "trailers [<revision-range>...] [-- <path>...]",
+	Short: "Report commit trailer usage across a range",
+	Long: `List the trailers (Co-authored-by, Reviewed-by, Signed-off-by and any custom
+"Key: value" lines at the end of commit messages) used across a range of
+commits, with how many commits carry each one and its most common values.
+
+Identity trailers such as "Co-authored-by: Jane Doe <jane@example.com>" are
+grouped by person after .mailmap and configured aliases, like authors.
+It accepts the same filters as glo log, including --where.
+
+Output formats:
+- color (default): Trailer keys with their top values
+- json: Full report
+- markdown: Markdown table
+
+Examples:
+  glo trailers                               # Trailers across HEAD
+  glo trailers v1.0.0..HEAD                  # Trailers used since a release
+  glo trailers --key=Reviewed-by --top=10    # Top reviewers
+  glo trailers --since="this quarter"        # Trailers this quarter
+  glo trailers --format=json                 # Export as JSON`,
+	Run: runTrailersCommand,
+}
+
+func runTrailersCommand(cmd *cobra.Command, args []string) {
+	gitExec := gitexec.NewGitExecutor()
+	if !gitExec.IsGitRepository() {
+		fmt.Fprintf(os.Stderr, "Error: Not a git repository\n")
+		os.Exit(1)
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	keys, _ := cmd.Flags().GetStringSlice("key")
+	top, _ := cmd.Flags().GetInt("top")
+
+	filters, err := readCommitFilters(cmd, args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	commits, err := filters.FetchCommits(gitExec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching git logs: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := trailers.Build(commits, keys, top)
+	trailersFormatter := formatters.NewTrailersFormatter(format == "color")
+
+	var output string
+	switch strings.ToLower(format) {
+	case "json":
+		output, err = trailersFormatter.FormatJSON(report)
+		output += "\n"
+	case "markdown", "md":
+		output = trailersFormatter.FormatMarkdown(report)
+	case "color", "":
+		output = trailersFormatter.FormatColor(report)
+	default:
+		err = fmt.Errorf("unknown format '%s'. Use: color, json, or markdown", format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+}
+
+func init() {
+	rootCmd.AddCommand(trailersCmd)
+
+	addCommitFilterFlags(trailersCmd)
+	trailersCmd.Flags().StringP("format", "f", "color", "Output format: color, json, markdown")
+	trailersCmd.Flags().StringSliceP("key", "k", nil, "Only report these trailer keys (repeatable)")
+	trailersCmd.Flags().IntP("top", "t", 5, "Values listed per trailer (0 = all)")
+}
@@ -0,0 +1,127 @@
+package activity
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+const (
+	PeriodDay         = "day"
+	PeriodWeek        = "week"
+	PeriodMonth       = "month"
+	PeriodWeekdayHour = "weekday-hour"
+)
+
+func ValidPeriod(period string) bool {
+	switch period {
+	case PeriodDay, PeriodWeek, PeriodMonth, PeriodWeekdayHour:
+		return true
+	}
+	return false
+}
+
+// Build buckets commits by calendar day, by the requested period and by
+// weekday×hour. Times are taken in the author's own time zone so that
+// "weekend work" means the weekend where the commit was made.
+func Build(commits []models.Commit, period string) (*models.ActivityReport, error) {
+	if !ValidPeriod(period) {
+		return nil, fmt.Errorf("unknown period '%s'. Use: day, week, month, or weekday-hour", period)
+	}
+
+	report := &models.ActivityReport{Period: period}
+
+	var times []time.Time
+	for _, commit := range commits {
+		if commit.Date.IsZero() {
+			continue
+		}
+		times = append(times, commit.Date)
+	}
+	if len(times) == 0 {
+		return report, nil
+	}
+
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	report.TotalCommits = len(times)
+	report.First = times[0]
+	report.Last = times[len(times)-1]
+
+	for _, t := range times {
+		weekday := t.Weekday()
+		report.Weekdays[weekday]++
+		report.Hours[t.Hour()]++
+		report.WeekdayHour[weekday][t.Hour()]++
+		if weekday == time.Saturday || weekday == time.Sunday {
+			report.WeekendCount++
+		}
+	}
+
+	report.Days = bucketize(times, PeriodDay)
+	if period != PeriodWeekdayHour {
+		report.Series = bucketize(times, period)
+	}
+
+	return report, nil
+}
+
+// bucketize returns one bucket per period between the first and last commit,
+// including empty ones, so gaps show up in charts.
+func bucketize(times []time.Time, period string) []models.ActivityBucket {
+	counts := make(map[string]int)
+	for _, t := range times {
+		counts[bucketStart(t, period).Format("2006-01-02")]++
+	}
+
+	var buckets []models.ActivityBucket
+	last := bucketStart(times[len(times)-1], period)
+	for start := bucketStart(times[0], period); !start.After(last); start = nextBucket(start, period) {
+		key := start.Format("2006-01-02")
+		buckets = append(buckets, models.ActivityBucket{
+			Label: bucketLabel(start, period),
+			Start: start,
+			End:   nextBucket(start, period),
+			Count: counts[key],
+		})
+	}
+
+	return buckets
+}
+
+func bucketStart(t time.Time, period string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case PeriodWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case PeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func nextBucket(start time.Time, period string) time.Time {
+	switch period {
+	case PeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case PeriodMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+func bucketLabel(start time.Time, period string) string {
+	switch period {
+	case PeriodWeek:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case PeriodMonth:
+		return start.Format("2006-01")
+	default:
+		return start.Format("2006-01-02")
+	}
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// RepoFileName is the per-repository config file, read from the root of the
+// working tree. Settings in it are merged over the user config.
+const RepoFileName = ".glo.json"
+
+// Alias maps other spellings of an author to one canonical identity. Match
+// entries are compared case-insensitively with both author names and
+// emails. Aliases are applied after .mailmap.
+type Alias struct {
+	Name  string   `json:"name"`
+	Email string   `json:"email"`
+	Match []string `json:"match"`
+}
+
+// References configures how pull request and issue references are found in
+// commit messages and linked. Patterns are regular expressions whose first
+// capture group, or the whole match without one, is the pull request number
+// or issue key; when set they replace the built-in patterns. The URL
+// templates turn a reference into a link by replacing {id} with it, e.g.
+// "https://github.com/org/repo/pull/{id}".
+type References struct {
+	PullRequestPatterns []string `json:"pull_request_patterns,omitempty"`
+	IssuePatterns       []string `json:"issue_patterns,omitempty"`
+	PullRequestURL      string   `json:"pull_request_url,omitempty"`
+	IssueURL            string   `json:"issue_url,omitempty"`
+}
+
+// Lint configures glo lint. Enable and Disable switch rules on and off by
+// name on top of the defaults. MaxSubjectLength defaults to 72 and Types to
+// the conventional commit types; RequiredTrailers turns on the
+// required-trailers rule.
+type Lint struct {
+	Enable           []string `json:"enable,omitempty"`
+	Disable          []string `json:"disable,omitempty"`
+	MaxSubjectLength int      `json:"max_subject_length,omitempty"`
+	Types            []string `json:"types,omitempty"`
+	RequiredTrailers []string `json:"required_trailers,omitempty"`
+}
+
+// Sprint is the team's sprint cadence used by "this sprint" and "last
+// sprint": sprints of Days days, the first starting on Start (YYYY-MM-DD).
+type Sprint struct {
+	Start string `json:"start"`
+	Days  int    `json:"days"`
+}
+
+type Config struct {
+	Aliases []Alias `json:"aliases,omitempty"`
+	// ProtectedBranches are glob patterns of branches glo branch prune never
+	// deletes, in addition to its built-in defaults.
+	ProtectedBranches []string `json:"protected_branches,omitempty"`
+	// References are overridden field by field, so a repository can set its
+	// own URLs while keeping the user's patterns.
+	References References `json:"references,omitempty"`
+	Lint       Lint       `json:"lint,omitempty"`
+	Sprint     *Sprint    `json:"sprint,omitempty"`
+}
+
+// UserPath returns the location of the user config, e.g.
+// ~/.config/glo/config.json on Linux.
+func UserPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "glo", "config.json"), nil
+}
+
+// Load reads the user config and the config of the repository at repoRoot.
+// Missing files are not an error; repoRoot may be empty outside a repository.
+func Load(repoRoot string) (*Config, error) {
+	cfg := &Config{}
+
+	if path, err := UserPath(); err == nil {
+		if err := cfg.merge(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if repoRoot != "" {
+		if err := cfg.merge(filepath.Join(repoRoot, RepoFileName)); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) merge(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var file Config
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	c.Aliases = append(c.Aliases, file.Aliases...)
+	c.ProtectedBranches = append(c.ProtectedBranches, file.ProtectedBranches...)
+	c.References.merge(file.References)
+	c.Lint.merge(file.Lint)
+	if file.Sprint != nil {
+		c.Sprint = file.Sprint
+	}
+	return nil
+}
+
+func (r *References) merge(other References) {
+	if len(other.PullRequestPatterns) > 0 {
+		r.PullRequestPatterns = other.PullRequestPatterns
+	}
+	if len(other.IssuePatterns) > 0 {
+		r.IssuePatterns = other.IssuePatterns
+	}
+	if other.PullRequestURL != "" {
+		r.PullRequestURL = other.PullRequestURL
+	}
+	if other.IssueURL != "" {
+		r.IssueURL = other.IssueURL
+	}
+}
+
+func (l *Lint) merge(other Lint) {
+	l.Enable = append(l.Enable, other.Enable...)
+	l.Disable = append(l.Disable, other.Disable...)
+	if other.MaxSubjectLength > 0 {
+		l.MaxSubjectLength = other.MaxSubjectLength
+	}
+	if len(other.Types) > 0 {
+		l.Types = other.Types
+	}
+	if len(other.RequiredTrailers) > 0 {
+		l.RequiredTrailers = other.RequiredTrailers
+	}
+}
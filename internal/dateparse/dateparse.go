@@ -0,0 +1,323 @@
+package dateparse
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sprint is a team's sprint cadence for "this sprint" and "last sprint":
+// sprints of Days days that start on Start and repeat back to back from
+// there.
+type Sprint struct {
+	Start time.Time
+	Days  int
+}
+
+// Range is a resolved date expression. Start is inclusive and End is
+// exclusive; for expressions naming an instant ("2 hours ago") they are equal.
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+var (
+	agoPattern     = regexp.MustCompile(`^(\d+|an?|one)\s+(second|minute|hour|day|week|month|year)s?\s+ago$`)
+	quarterPattern = regexp.MustCompile(`^(?:q([1-4])(?:\s+|-)?(\d{4})?|(\d{4})-?q([1-4]))$`)
+	isoWeekPattern = regexp.MustCompile(`^(?:(\d{4})-?)?w(\d{1,2})$`)
+	monthPattern   = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+	yearPattern    = regexp.MustCompile(`^\d{4}$`)
+)
+
+var instantLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday,
+	"wednesday": time.Wednesday, "thursday": time.Thursday, "friday": time.Friday,
+	"saturday": time.Saturday,
+
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"january": time.January, "february": time.February, "march": time.March,
+	"april": time.April, "may": time.May, "june": time.June, "july": time.July,
+	"august": time.August, "september": time.September, "october": time.October,
+	"november": time.November, "december": time.December,
+
+	"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
+	"jun": time.June, "jul": time.July, "aug": time.August, "sep": time.September,
+	"oct": time.October, "nov": time.November, "dec": time.December,
+}
+
+// Parse resolves expr relative to now. It understands ISO dates and
+// timestamps, "today"/"yesterday", "N units ago", "[last|this] <weekday>",
+// "last|this week|month|quarter|year|sprint", quarters ("Q3 2025"),
+// ISO weeks ("2025-W14"), months ("March 2025", "2025-03") and years.
+// Sprints can only be resolved when sprint is given.
+func Parse(expr string, now time.Time, sprint *Sprint) (Range, error) {
+	text := strings.Join(strings.Fields(strings.ToLower(expr)), " ")
+	if text == "" {
+		return Range{}, fmt.Errorf("empty date expression")
+	}
+
+	for _, layout := range instantLayouts {
+		if t, err := time.ParseInLocation(layout, expr, now.Location()); err == nil {
+			return Range{Start: t, End: t}, nil
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01-02", text, now.Location()); err == nil {
+		return dayRange(t), nil
+	}
+
+	today := startOfDay(now)
+	switch text {
+	case "now":
+		return Range{Start: now, End: now}, nil
+	case "today":
+		return dayRange(today), nil
+	case "yesterday":
+		return dayRange(today.AddDate(0, 0, -1)), nil
+	}
+
+	if match := agoPattern.FindStringSubmatch(text); match != nil {
+		n := 1
+		if v, err := strconv.Atoi(match[1]); err == nil {
+			n = v
+		}
+		t := subtract(now, n, match[2])
+		return Range{Start: t, End: t}, nil
+	}
+
+	if text == "this sprint" || text == "last sprint" {
+		return sprintRange(text, now, sprint)
+	}
+
+	if r, ok := relativePeriod(text, now); ok {
+		return r, nil
+	}
+
+	if r, ok := weekdayRange(text, today); ok {
+		return r, nil
+	}
+
+	if match := quarterPattern.FindStringSubmatch(text); match != nil {
+		quarter, yearText := match[1], match[2]
+		if quarter == "" {
+			quarter, yearText = match[4], match[3]
+		}
+		year := now.Year()
+		if yearText != "" {
+			year, _ = strconv.Atoi(yearText)
+		}
+		q, _ := strconv.Atoi(quarter)
+		start := time.Date(year, time.Month(3*(q-1)+1), 1, 0, 0, 0, 0, now.Location())
+		return Range{Start: start, End: start.AddDate(0, 3, 0)}, nil
+	}
+
+	if match := isoWeekPattern.FindStringSubmatch(text); match != nil {
+		year := now.Year()
+		if match[1] != "" {
+			year, _ = strconv.Atoi(match[1])
+		}
+		week, _ := strconv.Atoi(match[2])
+		if week < 1 || week > 53 {
+			return Range{}, fmt.Errorf("invalid ISO week in '%s'", expr)
+		}
+		start := isoWeekStart(year, week, now.Location())
+		return Range{Start: start, End: start.AddDate(0, 0, 7)}, nil
+	}
+
+	if match := monthPattern.FindStringSubmatch(text); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		month, _ := strconv.Atoi(match[2])
+		if month >= 1 && month <= 12 {
+			return monthRange(year, time.Month(month), now.Location()), nil
+		}
+	}
+
+	if fields := strings.Fields(text); len(fields) <= 2 {
+		if month, ok := months[fields[0]]; ok {
+			year := now.Year()
+			if len(fields) == 2 {
+				if !yearPattern.MatchString(fields[1]) {
+					return Range{}, fmt.Errorf("cannot parse date '%s'", expr)
+				}
+				year, _ = strconv.Atoi(fields[1])
+			}
+			return monthRange(year, month, now.Location()), nil
+		}
+	}
+
+	if yearPattern.MatchString(text) {
+		year, _ := strconv.Atoi(text)
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, now.Location())
+		return Range{Start: start, End: start.AddDate(1, 0, 0)}, nil
+	}
+
+	return Range{}, fmt.Errorf("cannot parse date '%s'", expr)
+}
+
+// ResolveSinceUntil turns --since/--until expressions into concrete bounds.
+// since resolves to the start of its range and until to the end, so
+// "--until yesterday" includes all of yesterday. Either may be empty.
+func ResolveSinceUntil(since, until string, now time.Time, sprint *Sprint) (time.Time, time.Time, error) {
+	var start, end time.Time
+
+	if since != "" {
+		r, err := Parse(since, now, sprint)
+		if err != nil {
+			return start, end, fmt.Errorf("invalid --since: %w", err)
+		}
+		start = r.Start
+	}
+
+	if until != "" {
+		r, err := Parse(until, now, sprint)
+		if err != nil {
+			return start, end, fmt.Errorf("invalid --until: %w", err)
+		}
+		end = r.End
+		if r.End.After(r.Start) {
+			end = r.End.Add(-time.Second)
+		}
+	}
+
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return start, end, fmt.Errorf("--until (%s) is before --since (%s)",
+			end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+
+	return start, end, nil
+}
+
+func relativePeriod(text string, now time.Time) (Range, bool) {
+	fields := strings.Fields(text)
+	if len(fields) != 2 || (fields[0] != "this" && fields[0] != "last") {
+		return Range{}, false
+	}
+
+	offset := 0
+	if fields[0] == "last" {
+		offset = -1
+	}
+
+	today := startOfDay(now)
+	switch fields[1] {
+	case "week":
+		start := today.AddDate(0, 0, -mondayOffset(today)+7*offset)
+		return Range{Start: start, End: start.AddDate(0, 0, 7)}, true
+	case "month":
+		start := time.Date(now.Year(), now.Month()+time.Month(offset), 1, 0, 0, 0, 0, now.Location())
+		return Range{Start: start, End: start.AddDate(0, 1, 0)}, true
+	case "quarter":
+		month := time.Month(3*((int(now.Month())-1)/3) + 1)
+		start := time.Date(now.Year(), month+time.Month(3*offset), 1, 0, 0, 0, 0, now.Location())
+		return Range{Start: start, End: start.AddDate(0, 3, 0)}, true
+	case "year":
+		start := time.Date(now.Year()+offset, time.January, 1, 0, 0, 0, 0, now.Location())
+		return Range{Start: start, End: start.AddDate(1, 0, 0)}, true
+	}
+
+	return Range{}, false
+}
+
+// sprintRange resolves "this sprint" or "last sprint". Sprints are counted
+// in calendar days so that they keep starting at midnight across daylight
+// saving changes.
+func sprintRange(text string, now time.Time, sprint *Sprint) (Range, error) {
+	if sprint == nil || sprint.Days <= 0 {
+		return Range{}, fmt.Errorf("'%s' needs the sprint cadence, e.g. {\"sprint\": {\"start\": \"2024-01-01\", \"days\": 14}} in .glo.json", text)
+	}
+
+	anchor := time.Date(sprint.Start.Year(), sprint.Start.Month(), sprint.Start.Day(), 0, 0, 0, 0, now.Location())
+	today := startOfDay(now)
+	days := int(math.Round(today.Sub(anchor).Hours() / 24))
+	sprints := days / sprint.Days
+	if days < 0 && days%sprint.Days != 0 {
+		sprints--
+	}
+	if strings.HasPrefix(text, "last") {
+		sprints--
+	}
+
+	start := anchor.AddDate(0, 0, sprints*sprint.Days)
+	return Range{Start: start, End: start.AddDate(0, 0, sprint.Days)}, nil
+}
+
+func weekdayRange(text string, today time.Time) (Range, bool) {
+	fields := strings.Fields(text)
+	name := fields[len(fields)-1]
+	weekday, ok := weekdays[name]
+	if !ok || len(fields) > 2 {
+		return Range{}, false
+	}
+
+	back := (int(today.Weekday()) - int(weekday) + 7) % 7
+	switch {
+	case len(fields) == 1:
+	case fields[0] == "last":
+		if back == 0 {
+			back = 7
+		}
+	case fields[0] == "this":
+		back = mondayOffset(today) - (int(weekday)+6)%7
+	default:
+		return Range{}, false
+	}
+
+	return dayRange(today.AddDate(0, 0, -back)), true
+}
+
+func subtract(now time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "second":
+		return now.Add(-time.Duration(n) * time.Second)
+	case "minute":
+		return now.Add(-time.Duration(n) * time.Minute)
+	case "hour":
+		return now.Add(-time.Duration(n) * time.Hour)
+	case "day":
+		return now.AddDate(0, 0, -n)
+	case "week":
+		return now.AddDate(0, 0, -7*n)
+	case "month":
+		return now.AddDate(0, -n, 0)
+	default:
+		return now.AddDate(-n, 0, 0)
+	}
+}
+
+func isoWeekStart(year, week int, location *time.Location) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, location)
+	weekOne := jan4.AddDate(0, 0, -mondayOffset(jan4))
+	return weekOne.AddDate(0, 0, 7*(week-1))
+}
+
+func mondayOffset(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func dayRange(day time.Time) Range {
+	return Range{Start: day, End: day.AddDate(0, 0, 1)}
+}
+
+func monthRange(year int, month time.Month, location *time.Location) Range {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, location)
+	return Range{Start: start, End: start.AddDate(0, 1, 0)}
+}
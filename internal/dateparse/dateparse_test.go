@@ -0,0 +1,138 @@
+package dateparse
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// now is a Wednesday afternoon.
+var now = time.Date(2025, time.July, 16, 15, 30, 0, 0, time.UTC)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	sprint := &Sprint{Start: day(2024, time.January, 1), Days: 14}
+
+	tests := []struct {
+		expr  string
+		start time.Time
+		end   time.Time
+	}{
+		{"2025-03-04", day(2025, time.March, 4), day(2025, time.March, 5)},
+		{"2025-03-04T10:20:00Z", time.Date(2025, time.March, 4, 10, 20, 0, 0, time.UTC), time.Date(2025, time.March, 4, 10, 20, 0, 0, time.UTC)},
+		{"now", now, now},
+		{"today", day(2025, time.July, 16), day(2025, time.July, 17)},
+		{"Yesterday", day(2025, time.July, 15), day(2025, time.July, 16)},
+		{"2 weeks ago", now.AddDate(0, 0, -14), now.AddDate(0, 0, -14)},
+		{"an hour ago", now.Add(-time.Hour), now.Add(-time.Hour)},
+		{"3  days   ago", now.AddDate(0, 0, -3), now.AddDate(0, 0, -3)},
+		{"monday", day(2025, time.July, 14), day(2025, time.July, 15)},
+		{"last monday", day(2025, time.July, 14), day(2025, time.July, 15)},
+		{"last wednesday", day(2025, time.July, 9), day(2025, time.July, 10)},
+		{"this friday", day(2025, time.July, 18), day(2025, time.July, 19)},
+		{"this week", day(2025, time.July, 14), day(2025, time.July, 21)},
+		{"last week", day(2025, time.July, 7), day(2025, time.July, 14)},
+		{"this month", day(2025, time.July, 1), day(2025, time.August, 1)},
+		{"last month", day(2025, time.June, 1), day(2025, time.July, 1)},
+		{"this quarter", day(2025, time.July, 1), day(2025, time.October, 1)},
+		{"last quarter", day(2025, time.April, 1), day(2025, time.July, 1)},
+		{"last year", day(2024, time.January, 1), day(2025, time.January, 1)},
+		{"Q3 2025", day(2025, time.July, 1), day(2025, time.October, 1)},
+		{"2024-q1", day(2024, time.January, 1), day(2024, time.April, 1)},
+		{"q2", day(2025, time.April, 1), day(2025, time.July, 1)},
+		{"2025-W14", day(2025, time.March, 31), day(2025, time.April, 7)},
+		{"2025-W01", day(2024, time.December, 30), day(2025, time.January, 6)},
+		{"March 2025", day(2025, time.March, 1), day(2025, time.April, 1)},
+		{"feb", day(2025, time.February, 1), day(2025, time.March, 1)},
+		{"2025-03", day(2025, time.March, 1), day(2025, time.April, 1)},
+		{"2024", day(2024, time.January, 1), day(2025, time.January, 1)},
+		{"this sprint", day(2025, time.July, 14), day(2025, time.July, 28)},
+		{"last sprint", day(2025, time.June, 30), day(2025, time.July, 14)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Parse(tt.expr, now, sprint)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.expr, err)
+			}
+			if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) {
+				t.Errorf("Parse(%q) = [%v, %v), want [%v, %v)", tt.expr, got.Start, got.End, tt.start, tt.end)
+			}
+		})
+	}
+}
+
+func TestParseSprintBeforeStart(t *testing.T) {
+	sprint := &Sprint{Start: day(2025, time.August, 1), Days: 14}
+
+	got, err := Parse("this sprint", now, sprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := day(2025, time.July, 4); !got.Start.Equal(want) {
+		t.Errorf("start = %v, want %v", got.Start, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		sprint  *Sprint
+		message string
+	}{
+		{"", nil, "empty"},
+		{"bogus", nil, "cannot parse"},
+		{"2025-W54", nil, "invalid ISO week"},
+		{"march of 2025", nil, "cannot parse"},
+		{"next monday", nil, "cannot parse"},
+		{"this sprint", nil, "sprint cadence"},
+		{"last sprint", &Sprint{Start: day(2024, time.January, 1)}, "sprint cadence"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr, now, tt.sprint)
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("Parse(%q) error = %v, want it to mention %q", tt.expr, err, tt.message)
+			}
+		})
+	}
+}
+
+func TestResolveSinceUntil(t *testing.T) {
+	tests := []struct {
+		since, until string
+		start, end   time.Time
+		wantErr      bool
+	}{
+		{"", "", time.Time{}, time.Time{}, false},
+		{"yesterday", "", day(2025, time.July, 15), time.Time{}, false},
+		{"", "yesterday", time.Time{}, day(2025, time.July, 16).Add(-time.Second), false},
+		{"last month", "last month", day(2025, time.June, 1), day(2025, time.July, 1).Add(-time.Second), false},
+		{"2 hours ago", "1 hour ago", now.Add(-2 * time.Hour), now.Add(-time.Hour), false},
+		{"today", "yesterday", time.Time{}, time.Time{}, true},
+		{"bogus", "", time.Time{}, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.since+"/"+tt.until, func(t *testing.T) {
+			start, end, err := ResolveSinceUntil(tt.since, tt.until, now, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !start.Equal(tt.start) || !end.Equal(tt.end) {
+				t.Errorf("got [%v, %v], want [%v, %v]", start, end, tt.start, tt.end)
+			}
+		})
+	}
+}
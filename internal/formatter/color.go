@@ -28,11 +28,30 @@ func NewColorFormatter() *ColorFormatter {
 func (cf *ColorFormatter) Format(commit models.Commit) string {
 	var result strings.Builder
 	
+	switch commit.Side {
+	case models.SideLeft:
+		result.WriteString(fmt.Sprintf("%s<%s ", ColorRed, ColorReset))
+	case models.SideRight:
+		result.WriteString(fmt.Sprintf("%s>%s ", ColorGreen, ColorReset))
+	case models.SideBoundary:
+		result.WriteString("- ")
+	}
+	
 	result.WriteString(fmt.Sprintf("%s%s%s ", ColorYellow, commit.Hash[:8], ColorReset))
 	
 	result.WriteString(fmt.Sprintf("%s%s%s ", ColorGreen, commit.Author, ColorReset))
+	if len(commit.CoAuthors) > 0 {
+		result.WriteString(fmt.Sprintf("%s(+ %s)%s ", ColorGreen, formatPeople(commit.CoAuthors), ColorReset))
+	}
 	
-	result.WriteString(fmt.Sprintf("%s%s%s ", ColorCyan, commit.Date, ColorReset))
+	result.WriteString(fmt.Sprintf("%s%s%s ", ColorCyan, FormatDate(commit.Date), ColorReset))
+	
+	if commit.IsMerge() {
+		result.WriteString(fmt.Sprintf("%s[merge]%s ", ColorPurple, ColorReset))
+	}
+	if commit.Signature != nil {
+		result.WriteString(fmt.Sprintf("%s[%s]%s ", SignatureColor(commit.Signature), commit.Signature.Label(), ColorReset))
+	}
 	
 	result.WriteString(commit.Message)
 	
@@ -54,4 +73,17 @@ func (cf *ColorFormatter) FormatList(commits []models.Commit) string {
 
 func (cf *ColorFormatter) FormatHeader(text string) string {
 	return fmt.Sprintf("%s%s%s%s", ColorBold, ColorBlue, text, ColorReset)
-}
\ No newline at end of file
+}
+
+// SignatureColor is green for good signatures, red for bad or revoked ones
+// and yellow for those that cannot be fully trusted.
+func SignatureColor(signature *models.Signature) string {
+	switch signature.Code {
+	case models.SignatureGood:
+		return ColorGreen
+	case models.SignatureBad, models.SignatureRevokedKey:
+		return ColorRed
+	default:
+		return ColorYellow
+	}
+}
@@ -0,0 +1,207 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DateRelative = "relative"
+	DateISO      = "iso"
+	DateShort    = "short"
+	DateLocal    = "local"
+	DateRFC3339  = "rfc3339"
+	DateCustom   = "custom"
+)
+
+type DateOptions struct {
+	Style    string
+	Layout   string
+	Location *time.Location
+}
+
+var dateOptions = DateOptions{Style: DateISO}
+
+// SetDateOptions configures how every formatter renders dates. customFormat is
+// a strftime-style pattern used with the custom style, and tz is "local",
+// "utc", an IANA zone name or a numeric offset such as +0530. An empty tz keeps
+// each date in the time zone it was recorded in.
+func SetDateOptions(style, customFormat, tz string) error {
+	options := DateOptions{Style: strings.ToLower(style)}
+
+	switch options.Style {
+	case "":
+		options.Style = DateISO
+	case DateRelative, DateISO, DateShort, DateLocal, DateRFC3339:
+	case DateCustom:
+		if customFormat == "" {
+			return fmt.Errorf("--date=custom requires --date-format")
+		}
+		options.Layout = strftimeToLayout(customFormat)
+	default:
+		return fmt.Errorf("unknown date style '%s'. Use: relative, iso, short, local, rfc3339, or custom", style)
+	}
+
+	if tz != "" {
+		location, err := ParseTimeZone(tz)
+		if err != nil {
+			return err
+		}
+		options.Location = location
+	}
+
+	dateOptions = options
+	return nil
+}
+
+func ParseTimeZone(tz string) (*time.Location, error) {
+	switch strings.ToLower(tz) {
+	case "local":
+		return time.Local, nil
+	case "utc", "z":
+		return time.UTC, nil
+	}
+
+	if t, err := time.Parse("-0700", tz); err == nil {
+		_, offset := t.Zone()
+		return time.FixedZone(tz, offset), nil
+	}
+	if t, err := time.Parse("-07:00", tz); err == nil {
+		_, offset := t.Zone()
+		return time.FixedZone(tz, offset), nil
+	}
+
+	location, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone '%s'", tz)
+	}
+	return location, nil
+}
+
+// ConvertDate moves t into the time zone selected with --tz, if any.
+func ConvertDate(t time.Time) time.Time {
+	if dateOptions.Location != nil {
+		return t.In(dateOptions.Location)
+	}
+	if dateOptions.Style == DateLocal {
+		return t.Local()
+	}
+	return t
+}
+
+func FormatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	t = ConvertDate(t)
+	switch dateOptions.Style {
+	case DateRelative:
+		return relativeDate(t, time.Now())
+	case DateShort:
+		return t.Format("2006-01-02")
+	case DateLocal:
+		return t.Format("2006-01-02 15:04:05")
+	case DateRFC3339:
+		return t.Format(time.RFC3339)
+	case DateCustom:
+		return t.Format(dateOptions.Layout)
+	default:
+		return t.Format("2006-01-02 15:04:05 -0700")
+	}
+}
+
+// FormatDateRange renders resolved --since/--until bounds for summaries. It
+// returns an empty string when neither bound is set.
+func FormatDateRange(since, until time.Time) string {
+	if since.IsZero() && until.IsZero() {
+		return ""
+	}
+
+	start, end := "beginning", "now"
+	if !since.IsZero() {
+		start = FormatDate(since)
+	}
+	if !until.IsZero() {
+		end = FormatDate(until)
+	}
+	return start + " → " + end
+}
+
+func relativeDate(t, now time.Time) string {
+	diff := now.Sub(t)
+	suffix := "ago"
+	if diff < 0 {
+		diff = -diff
+		suffix = "from now"
+	}
+
+	switch {
+	case diff < time.Minute:
+		return "just now"
+	case diff < time.Hour:
+		return pluralize(int(diff.Minutes()), "minute", suffix)
+	case diff < 24*time.Hour:
+		return pluralize(int(diff.Hours()), "hour", suffix)
+	case diff < 14*24*time.Hour:
+		return pluralize(int(diff.Hours()/24), "day", suffix)
+	case diff < 10*7*24*time.Hour:
+		return pluralize(int(diff.Hours()/(24*7)), "week", suffix)
+	case diff < 365*24*time.Hour:
+		return pluralize(int(diff.Hours()/(24*30)), "month", suffix)
+	default:
+		return pluralize(int(diff.Hours()/(24*365)), "year", suffix)
+	}
+}
+
+func pluralize(n int, unit, suffix string) string {
+	if n != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s %s", n, unit, suffix)
+}
+
+var strftimeLayouts = map[byte]string{
+	'Y': "2006",
+	'y': "06",
+	'm': "01",
+	'd': "02",
+	'e': "_2",
+	'b': "Jan",
+	'h': "Jan",
+	'B': "January",
+	'a': "Mon",
+	'A': "Monday",
+	'H': "15",
+	'I': "03",
+	'M': "04",
+	'S': "05",
+	'p': "PM",
+	'z': "-0700",
+	'Z': "MST",
+	'F': "2006-01-02",
+	'T': "15:04:05",
+	'%': "%",
+}
+
+func strftimeToLayout(format string) string {
+	var layout strings.Builder
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			layout.WriteByte(format[i])
+			continue
+		}
+
+		i++
+		if directive, ok := strftimeLayouts[format[i]]; ok {
+			layout.WriteString(directive)
+		} else {
+			layout.WriteByte('%')
+			layout.WriteByte(format[i])
+		}
+	}
+
+	return layout.String()
+}
@@ -48,12 +48,17 @@ func (jf *JSONFormatter) FormatList(commits []models.Commit) string {
 	return string(data)
 }
 
-func (jf *JSONFormatter) FormatSummary(commits []models.Commit, metadata map[string]interface{}) string {
+func (jf *JSONFormatter) FormatSummary(commits []models.Commit, metadata map[string]interface{}, options SummaryOptions) string {
 	summary := map[string]interface{}{
 		"total_commits": len(commits),
+		"merge_commits": CountMerges(commits),
+		"authors":       models.CountCommitsByAuthor(commits, options.CoAuthors).Ranked(),
 		"commits":       commits,
 		"metadata":      metadata,
 	}
+	if options.Signatures {
+		summary["unsigned_commits"] = len(UnsignedCommits(commits))
+	}
 	
 	var data []byte
 	var err error
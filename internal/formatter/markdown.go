@@ -20,7 +20,22 @@ func (mf *MarkdownFormatter) Format(commit models.Commit) string {
 	result.WriteString(fmt.Sprintf("## %s\n\n", commit.Message))
 	result.WriteString(fmt.Sprintf("**Hash:** `%s`\n\n", commit.Hash[:8]))
 	result.WriteString(fmt.Sprintf("**Author:** %s\n\n", commit.Author))
-	result.WriteString(fmt.Sprintf("**Date:** %s\n\n", commit.Date))
+	if len(commit.CoAuthors) > 0 {
+		result.WriteString(fmt.Sprintf("**Co-authors:** %s\n\n", formatPeople(commit.CoAuthors)))
+	}
+	result.WriteString(fmt.Sprintf("**Date:** %s\n\n", FormatDate(commit.Date)))
+	if commit.IsMerge() {
+		result.WriteString(fmt.Sprintf("**Merge of:** %s\n\n", formatParents(commit.Parents)))
+	}
+	if commit.Signature != nil {
+		result.WriteString(fmt.Sprintf("**Signature:** %s\n\n", FormatSignature(commit.Signature)))
+	}
+	if len(commit.References) > 0 {
+		result.WriteString(fmt.Sprintf("**References:** %s\n\n", FormatReferenceLinks(commit.References)))
+	}
+	if len(commit.Paths) > 0 {
+		result.WriteString(fmt.Sprintf("**Paths:** %s\n\n", formatPaths(commit.Paths)))
+	}
 	result.WriteString("---\n\n")
 	
 	return result.String()
@@ -38,7 +53,26 @@ func (mf *MarkdownFormatter) FormatList(commits []models.Commit) string {
 		result.WriteString(fmt.Sprintf("### %d. %s\n\n", i+1, commit.Message))
 		result.WriteString(fmt.Sprintf("- **Hash:** `%s`\n", commit.Hash[:8]))
 		result.WriteString(fmt.Sprintf("- **Author:** %s\n", commit.Author))
-		result.WriteString(fmt.Sprintf("- **Date:** %s\n\n", commit.Date))
+		if len(commit.CoAuthors) > 0 {
+			result.WriteString(fmt.Sprintf("- **Co-authors:** %s\n", formatPeople(commit.CoAuthors)))
+		}
+		result.WriteString(fmt.Sprintf("- **Date:** %s\n", FormatDate(commit.Date)))
+		if commit.IsMerge() {
+			result.WriteString(fmt.Sprintf("- **Merge of:** %s\n", formatParents(commit.Parents)))
+		}
+		if commit.Signature != nil {
+			result.WriteString(fmt.Sprintf("- **Signature:** %s\n", FormatSignature(commit.Signature)))
+		}
+		if len(commit.References) > 0 {
+			result.WriteString(fmt.Sprintf("- **References:** %s\n", FormatReferenceLinks(commit.References)))
+		}
+		if len(commit.Paths) > 0 {
+			result.WriteString(fmt.Sprintf("- **Paths:** %s\n", formatPaths(commit.Paths)))
+		}
+		if commit.Side != "" {
+			result.WriteString(fmt.Sprintf("- **Side:** %s\n", commit.Side))
+		}
+		result.WriteString("\n")
 		
 		if i < len(commits)-1 {
 			result.WriteString("---\n\n")
@@ -49,37 +83,116 @@ func (mf *MarkdownFormatter) FormatList(commits []models.Commit) string {
 }
 
 func (mf *MarkdownFormatter) FormatTable(commits []models.Commit) string {
+	return "# Git Commit History\n\n" + mf.FormatCommitTable(commits)
+}
+
+// FormatCommitTable renders commits as a Markdown table without a heading,
+// for embedding in larger reports.
+func (mf *MarkdownFormatter) FormatCommitTable(commits []models.Commit) string {
 	var result strings.Builder
 	
-	result.WriteString("# Git Commit History\n\n")
-	result.WriteString("| Hash | Author | Date | Message |\n")
-	result.WriteString("|------|--------|------|---------|\n")
+	withPaths, withSide, withMerges, withReferences, withSignatures := false, false, false, false, false
+	for _, commit := range commits {
+		withSignatures = withSignatures || commit.Signature != nil
+		withReferences = withReferences || len(commit.References) > 0
+		withPaths = withPaths || len(commit.Paths) > 0
+		withSide = withSide || commit.Side != ""
+		withMerges = withMerges || commit.IsMerge()
+	}
+	
+	header, divider := "| Hash | Author | Date | Message |", "|------|--------|------|---------|"
+	if withSide {
+		header, divider = "| Side "+header, "|------"+divider
+	}
+	if withMerges {
+		header, divider = header+" Merge |", divider+"-------|"
+	}
+	if withSignatures {
+		header, divider = header+" Signature |", divider+"-----------|"
+	}
+	if withReferences {
+		header, divider = header+" References |", divider+"------------|"
+	}
+	if withPaths {
+		header, divider = header+" Paths |", divider+"-------|"
+	}
+	
+	result.WriteString(header + "\n")
+	result.WriteString(divider + "\n")
 	
 	for _, commit := range commits {
-		result.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s |\n",
+		if withSide {
+			result.WriteString(fmt.Sprintf("| %s ", commit.Side))
+		}
+		result.WriteString(fmt.Sprintf("| `%s` | %s | %s | %s |",
 			commit.Hash[:8],
 			commit.Author,
-			commit.Date,
-			strings.ReplaceAll(commit.Message, "|", "\\|"))) 
+			FormatDate(commit.Date),
+			strings.ReplaceAll(commit.Message, "|", "\\|")))
+		if withMerges {
+			merge := ""
+			if commit.IsMerge() {
+				merge = "yes"
+			}
+			result.WriteString(fmt.Sprintf(" %s |", merge))
+		}
+		if withSignatures {
+			signature := "unsigned"
+			if commit.Signature != nil {
+				signature = commit.Signature.Label()
+			}
+			result.WriteString(fmt.Sprintf(" %s |", signature))
+		}
+		if withReferences {
+			result.WriteString(fmt.Sprintf(" %s |", FormatReferenceLinks(commit.References)))
+		}
+		if withPaths {
+			result.WriteString(fmt.Sprintf(" %s |", formatPaths(commit.Paths)))
+		}
+		result.WriteString("\n")
 	}
 	
 	return result.String()
 }
 
-func (mf *MarkdownFormatter) FormatSummary(commits []models.Commit) string {
+func (mf *MarkdownFormatter) FormatSummary(commits []models.Commit, options SummaryOptions) string {
 	var result strings.Builder
 	
-	authorCount := make(map[string]int)
-	for _, commit := range commits {
-		authorCount[commit.Author]++
-	}
-	
 	result.WriteString("# Git Repository Summary\n\n")
+	if dateRange := FormatDateRange(options.Since, options.Until); dateRange != "" {
+		result.WriteString(fmt.Sprintf("**Date Range:** %s\n\n", dateRange))
+	}
+	if len(options.Paths) > 0 {
+		result.WriteString(fmt.Sprintf("**Paths:** %s\n\n", formatPaths(options.Paths)))
+	}
 	result.WriteString(fmt.Sprintf("**Total Commits:** %d\n\n", len(commits)))
+	if merges := CountMerges(commits); merges > 0 {
+		result.WriteString(fmt.Sprintf("**Merge Commits:** %d\n\n", merges))
+	}
+	var unsigned []models.Commit
+	if options.Signatures {
+		unsigned = UnsignedCommits(commits)
+		result.WriteString(fmt.Sprintf("**Unsigned Commits:** %d of %d\n\n", len(unsigned), len(commits)))
+	}
 	result.WriteString("## Commits by Author\n\n")
 	
-	for author, count := range authorCount {
-		result.WriteString(fmt.Sprintf("- **%s:** %d commits\n", author, count))
+	for _, author := range models.CountCommitsByAuthor(commits, options.CoAuthors).Ranked() {
+		result.WriteString(fmt.Sprintf("- **%s:** %d commits\n", author.Author, author.Count))
+	}
+	
+	if len(unsigned) > 0 {
+		result.WriteString("\n## Unsigned Commits by Author\n\n")
+		for _, author := range models.CountCommitsByAuthor(unsigned, false).Ranked() {
+			result.WriteString(fmt.Sprintf("- **%s:** %d commits\n", author.Author, author.Count))
+		}
+	}
+	
+	if len(options.Paths) > 1 {
+		pathCount := CountByPath(commits, options.Paths)
+		result.WriteString("\n## Commits by Path\n\n")
+		for _, path := range options.Paths {
+			result.WriteString(fmt.Sprintf("- `%s`: %d commits\n", path, pathCount[path]))
+		}
 	}
 	
 	result.WriteString("\n---\n\n")
@@ -97,4 +210,59 @@ func (mf *MarkdownFormatter) FormatSummary(commits []models.Commit) string {
 	}
 	
 	return result.String()
-}
\ No newline at end of file
+}
+
+func formatPeople(people []models.Person) string {
+	names := make([]string, len(people))
+	for i, person := range people {
+		names[i] = person.Name
+		if names[i] == "" {
+			names[i] = person.Email
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// FormatReferenceLinks renders pull request and issue references as
+// Markdown links where a URL is known and as plain labels otherwise.
+func FormatReferenceLinks(references []models.Reference) string {
+	links := make([]string, len(references))
+	for i, reference := range references {
+		links[i] = reference.Label()
+		if reference.URL != "" {
+			links[i] = fmt.Sprintf("[%s](%s)", reference.Label(), reference.URL)
+		}
+	}
+	return strings.Join(links, ", ")
+}
+
+// FormatSignature describes a signature with its signer and key when git
+// knows them.
+func FormatSignature(signature *models.Signature) string {
+	text := signature.Label()
+	if signature.Signer != "" {
+		text += " by " + signature.Signer
+	}
+	if signature.Key != "" {
+		text += fmt.Sprintf(" (key `%s`)", signature.Key)
+	}
+	return text
+}
+
+// formatParents lists the short hashes of a merge's parents, first parent
+// first.
+func formatParents(parents []string) string {
+	short := make([]string, len(parents))
+	for i, parent := range parents {
+		short[i] = "`" + parent[:min(8, len(parent))] + "`"
+	}
+	return strings.Join(short, ", ")
+}
+
+func formatPaths(paths []string) string {
+	quoted := make([]string, len(paths))
+	for i, path := range paths {
+		quoted[i] = "`" + path + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,59 @@
+package formatter
+
+import (
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// SummaryOptions describes the scope a summary was computed over so that it
+// can be echoed back alongside the statistics.
+type SummaryOptions struct {
+	Since time.Time
+	Until time.Time
+	Paths []string
+	// CoAuthors credits co-authors alongside the author in the per-author
+	// counts.
+	CoAuthors bool
+	// Signatures counts the unsigned commits; signatures must have been
+	// verified.
+	Signatures bool
+}
+
+// CountByPath returns how many commits touched each requested path.
+func CountByPath(commits []models.Commit, paths []string) map[string]int {
+	counts := make(map[string]int, len(paths))
+	for _, path := range paths {
+		counts[path] = 0
+	}
+	for _, commit := range commits {
+		for _, path := range commit.Paths {
+			counts[path]++
+		}
+	}
+	return counts
+}
+
+// CountMerges returns how many of the commits are merges.
+func CountMerges(commits []models.Commit) int {
+	count := 0
+	for _, commit := range commits {
+		if commit.IsMerge() {
+			count++
+		}
+	}
+	return count
+}
+
+// UnsignedCommits returns the commits without a good signature from a
+// trusted key: unsigned ones and those whose signature is bad, untrusted or
+// cannot be verified.
+func UnsignedCommits(commits []models.Commit) []models.Commit {
+	var unsigned []models.Commit
+	for _, commit := range commits {
+		if !commit.HasValidSignature() {
+			unsigned = append(unsigned, commit)
+		}
+	}
+	return unsigned
+}
@@ -0,0 +1,331 @@
+package formatters
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+const (
+	heatmapWeeks   = 53
+	sparklineWidth = 80
+	barChartRows   = 20
+	barChartWidth  = 40
+)
+
+var (
+	sparkBlocks   = []rune("▁▂▃▄▅▆▇█")
+	heatmapBlocks = []string{"·", "░", "▒", "▓", "█"}
+	weekdayNames  = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+)
+
+type ActivityFormatter struct {
+	useColor bool
+}
+
+func NewActivityFormatter(useColor bool) *ActivityFormatter {
+	return &ActivityFormatter{
+		useColor: useColor,
+	}
+}
+
+func (af *ActivityFormatter) FormatJSON(report *models.ActivityReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (af *ActivityFormatter) FormatCSV(report *models.ActivityReport) (string, error) {
+	var result strings.Builder
+	writer := csv.NewWriter(&result)
+
+	if report.Period == "weekday-hour" {
+		if err := writer.Write([]string{"weekday", "hour", "count"}); err != nil {
+			return "", err
+		}
+		for day := range report.WeekdayHour {
+			for hour, count := range report.WeekdayHour[day] {
+				if err := writer.Write([]string{weekdayNames[day], strconv.Itoa(hour), strconv.Itoa(count)}); err != nil {
+					return "", err
+				}
+			}
+		}
+	} else {
+		if err := writer.Write([]string{report.Period, "start", "end", "count"}); err != nil {
+			return "", err
+		}
+		for _, bucket := range report.Series {
+			row := []string{
+				bucket.Label,
+				bucket.Start.Format("2006-01-02"),
+				bucket.End.Format("2006-01-02"),
+				strconv.Itoa(bucket.Count),
+			}
+			if err := writer.Write(row); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	writer.Flush()
+	return result.String(), writer.Error()
+}
+
+func (af *ActivityFormatter) FormatColor(report *models.ActivityReport) string {
+	var result strings.Builder
+
+	result.WriteString(af.colorize("Commit Activity", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if report.TotalCommits == 0 {
+		result.WriteString("No commits found matching the criteria.\n")
+		return result.String()
+	}
+
+	result.WriteString(fmt.Sprintf("Total commits: %d (%s → %s)\n",
+		report.TotalCommits, formatter.FormatDate(report.First), formatter.FormatDate(report.Last)))
+	result.WriteString(fmt.Sprintf("Weekend commits: %d (%.0f%%)\n\n",
+		report.WeekendCount, float64(report.WeekendCount)*100/float64(report.TotalCommits)))
+
+	result.WriteString(af.formatHeatmap(report))
+	result.WriteString("\n")
+
+	if len(report.Series) > 0 {
+		result.WriteString(af.formatSeries(report))
+		result.WriteString("\n")
+	}
+
+	result.WriteString(af.formatWeekdays(report))
+	result.WriteString("\n")
+	result.WriteString(af.formatPunchcard(report))
+
+	return result.String()
+}
+
+func (af *ActivityFormatter) formatHeatmap(report *models.ActivityReport) string {
+	var result strings.Builder
+
+	counts := make(map[string]int)
+	maxCount := 0
+	for _, day := range report.Days {
+		counts[day.Label] = day.Count
+		if day.Count > maxCount {
+			maxCount = day.Count
+		}
+	}
+
+	last := report.Days[len(report.Days)-1].Start
+	end := last.AddDate(0, 0, 6-int(last.Weekday()))
+	start := end.AddDate(0, 0, -heatmapWeeks*7+1)
+	first := report.Days[0].Start
+	if first.After(start) {
+		start = first.AddDate(0, 0, -int(first.Weekday()))
+	}
+	weeks := int(end.Sub(start).Hours()/24+1) / 7
+
+	result.WriteString(af.colorize("Daily Heatmap", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+
+	monthRow := []rune(strings.Repeat(" ", weeks*2))
+	labelEnd := -1
+	for week := 0; week < weeks; week++ {
+		weekStart := start.AddDate(0, 0, week*7)
+		if (week == 0 || weekStart.Day() <= 7) && week*2 > labelEnd {
+			label := []rune(weekStart.Format("Jan"))
+			for i := 0; i < len(label) && week*2+i < len(monthRow); i++ {
+				monthRow[week*2+i] = label[i]
+			}
+			labelEnd = week*2 + len(label)
+		}
+	}
+	result.WriteString("     " + strings.TrimRight(string(monthRow), " ") + "\n")
+
+	for weekday := 0; weekday < 7; weekday++ {
+		label := "    "
+		if weekday%2 == 1 {
+			label = weekdayNames[weekday] + " "
+		}
+		result.WriteString(label + " ")
+
+		for week := 0; week < weeks; week++ {
+			day := start.AddDate(0, 0, week*7+weekday)
+			if day.Before(first) || day.After(last) {
+				result.WriteString("  ")
+				continue
+			}
+			result.WriteString(af.heatCell(counts[day.Format("2006-01-02")], maxCount))
+			result.WriteString(" ")
+		}
+		result.WriteString("\n")
+	}
+
+	result.WriteString("     Less ")
+	for level := range heatmapBlocks {
+		result.WriteString(af.heatLevel(level))
+		result.WriteString(" ")
+	}
+	result.WriteString("More\n")
+
+	return result.String()
+}
+
+func (af *ActivityFormatter) formatSeries(report *models.ActivityReport) string {
+	var result strings.Builder
+
+	series := report.Series
+	title := fmt.Sprintf("Commits per %s", report.Period)
+	if len(series) > sparklineWidth {
+		series = series[len(series)-sparklineWidth:]
+		title += fmt.Sprintf(" (last %d)", sparklineWidth)
+	}
+
+	values := make([]int, len(series))
+	for i, bucket := range series {
+		values[i] = bucket.Count
+	}
+
+	result.WriteString(af.colorize(title, formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+	result.WriteString(fmt.Sprintf("%s  %s  %s\n",
+		series[0].Label, af.colorize(sparkline(values), formatter.ColorGreen), series[len(series)-1].Label))
+	result.WriteString("\n")
+
+	if len(series) > barChartRows {
+		series = series[len(series)-barChartRows:]
+	}
+	maxCount := 0
+	for _, bucket := range series {
+		if bucket.Count > maxCount {
+			maxCount = bucket.Count
+		}
+	}
+	for _, bucket := range series {
+		result.WriteString(fmt.Sprintf("  %-10s %s %d\n",
+			bucket.Label, af.colorize(bar(bucket.Count, maxCount), formatter.ColorGreen), bucket.Count))
+	}
+
+	return result.String()
+}
+
+func (af *ActivityFormatter) formatWeekdays(report *models.ActivityReport) string {
+	var result strings.Builder
+
+	result.WriteString(af.colorize("Commits by Weekday", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+
+	maxCount := 0
+	for _, count := range report.Weekdays {
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	for i := 1; i <= 7; i++ {
+		weekday := i % 7
+		color := formatter.ColorGreen
+		if weekday == int(time.Saturday) || weekday == int(time.Sunday) {
+			color = formatter.ColorYellow
+		}
+		count := report.Weekdays[weekday]
+		result.WriteString(fmt.Sprintf("  %s %s %d\n",
+			weekdayNames[weekday], af.colorize(bar(count, maxCount), color), count))
+	}
+
+	return result.String()
+}
+
+func (af *ActivityFormatter) formatPunchcard(report *models.ActivityReport) string {
+	var result strings.Builder
+
+	result.WriteString(af.colorize("Weekday × Hour", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+
+	maxCount := 0
+	for day := range report.WeekdayHour {
+		for _, count := range report.WeekdayHour[day] {
+			if count > maxCount {
+				maxCount = count
+			}
+		}
+	}
+
+	result.WriteString("      0           6           12          18        23\n")
+	for i := 1; i <= 7; i++ {
+		weekday := i % 7
+		result.WriteString(fmt.Sprintf("  %s ", weekdayNames[weekday]))
+		for hour := 0; hour < 24; hour++ {
+			result.WriteString(af.heatCell(report.WeekdayHour[weekday][hour], maxCount))
+			result.WriteString(" ")
+		}
+		result.WriteString("\n")
+	}
+
+	result.WriteString(fmt.Sprintf("  Hours %s\n", af.colorize(sparkline(report.Hours[:]), formatter.ColorGreen)))
+
+	return result.String()
+}
+
+func (af *ActivityFormatter) heatCell(count, maxCount int) string {
+	if count == 0 || maxCount == 0 {
+		return af.heatLevel(0)
+	}
+	level := (count*(len(heatmapBlocks)-1) + maxCount - 1) / maxCount
+	return af.heatLevel(level)
+}
+
+func (af *ActivityFormatter) heatLevel(level int) string {
+	if level == 0 {
+		return af.colorize(heatmapBlocks[0], formatter.ColorWhite)
+	}
+	return af.colorize(heatmapBlocks[level], formatter.ColorGreen)
+}
+
+func (af *ActivityFormatter) colorize(text, color string) string {
+	if !af.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+func sparkline(values []int) string {
+	maxValue := 0
+	for _, value := range values {
+		if value > maxValue {
+			maxValue = value
+		}
+	}
+
+	var result strings.Builder
+	for _, value := range values {
+		if maxValue == 0 {
+			result.WriteRune(' ')
+			continue
+		}
+		index := value * (len(sparkBlocks) - 1) / maxValue
+		if value == 0 {
+			result.WriteRune(' ')
+		} else {
+			result.WriteRune(sparkBlocks[index])
+		}
+	}
+	return result.String()
+}
+
+func bar(value, maxValue int) string {
+	if maxValue == 0 || value == 0 {
+		return ""
+	}
+	width := value * barChartWidth / maxValue
+	if width == 0 {
+		width = 1
+	}
+	return strings.Repeat("█", width)
+}
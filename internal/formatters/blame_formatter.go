@@ -0,0 +1,178 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// ageGradient runs from the newest to the oldest change in a file using the
+// 256-color palette: fresh lines are green and fade through yellow and red
+// to dark blue.
+var ageGradient = []int{46, 82, 118, 154, 190, 226, 220, 214, 208, 202, 167, 131, 96, 61, 25}
+
+const blameAuthorWidth = 18
+
+type BlameFormatter struct {
+	useColor bool
+}
+
+func NewBlameFormatter(useColor bool) *BlameFormatter {
+	return &BlameFormatter{
+		useColor: useColor,
+	}
+}
+
+func (bf *BlameFormatter) FormatJSON(blame *models.BlameFile) (string, error) {
+	data, err := json.MarshalIndent(blame, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (bf *BlameFormatter) FormatSummaryJSON(blame *models.BlameFile) (string, error) {
+	summary := map[string]interface{}{
+		"path":        blame.Path,
+		"revision":    blame.Revision,
+		"total_lines": blame.TotalLines,
+		"authors":     blame.Ownership(),
+	}
+
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (bf *BlameFormatter) FormatColor(blame *models.BlameFile) string {
+	var result strings.Builder
+
+	result.WriteString(bf.colorize(fmt.Sprintf("Blame: %s", blame.Path), formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if blame.TotalLines == 0 {
+		result.WriteString("File is empty.\n")
+		return result.String()
+	}
+
+	oldest, newest := dateBounds(blame.Ranges)
+	dateWidth := 0
+	for _, r := range blame.Ranges {
+		dateWidth = max(dateWidth, utf8.RuneCountInString(formatter.FormatDate(r.Date)))
+	}
+	numberWidth := len(fmt.Sprint(blame.TotalLines))
+	metaWidth := 8 + 1 + blameAuthorWidth + 1 + dateWidth
+
+	for _, r := range blame.Ranges {
+		color := bf.ageColor(r, oldest, newest)
+
+		for i, line := range r.Lines {
+			meta := strings.Repeat(" ", metaWidth)
+			if i == 0 {
+				hash, author := r.Hash[:8], r.Author
+				if !r.IsCommitted() {
+					hash = "--------"
+				}
+				meta = fmt.Sprintf("%s %-*s %-*s",
+					hash, blameAuthorWidth, truncateString(author, blameAuthorWidth),
+					dateWidth, formatter.FormatDate(r.Date))
+			}
+
+			result.WriteString(bf.colorize(meta, color))
+			result.WriteString(bf.colorize(fmt.Sprintf(" │ %*d │ ", numberWidth, r.StartLine+i), color))
+			result.WriteString(line)
+			result.WriteString("\n")
+		}
+	}
+
+	result.WriteString("\n")
+	result.WriteString(bf.formatLegend(oldest, newest))
+
+	return result.String()
+}
+
+func (bf *BlameFormatter) FormatSummary(blame *models.BlameFile) string {
+	var result strings.Builder
+
+	result.WriteString(bf.colorize(fmt.Sprintf("Ownership of %s", blame.Path), formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+	result.WriteString(fmt.Sprintf("Total lines: %d\n\n", blame.TotalLines))
+
+	shares := blame.Ownership()
+	for _, share := range shares {
+		width := int(share.Percent * barChartWidth / 100)
+		if width == 0 && share.Lines > 0 {
+			width = 1
+		}
+		result.WriteString(fmt.Sprintf("  %-*s %5d lines %5.1f%% %s\n",
+			blameAuthorWidth, truncateString(share.Author, blameAuthorWidth),
+			share.Lines, share.Percent,
+			bf.colorize(strings.Repeat("█", width), formatter.ColorGreen)))
+	}
+
+	return result.String()
+}
+
+func (bf *BlameFormatter) formatLegend(oldest, newest time.Time) string {
+	var legend strings.Builder
+
+	legend.WriteString("Age: newest ")
+	for _, code := range ageGradient {
+		legend.WriteString(bf.colorize("█", color256(code)))
+	}
+	legend.WriteString(" oldest")
+	if !oldest.IsZero() {
+		legend.WriteString(fmt.Sprintf(" (%s → %s)", formatter.FormatDate(newest), formatter.FormatDate(oldest)))
+	}
+	legend.WriteString("\n")
+
+	return legend.String()
+}
+
+func (bf *BlameFormatter) ageColor(r models.BlameRange, oldest, newest time.Time) string {
+	if !r.IsCommitted() {
+		return formatter.ColorWhite
+	}
+
+	span := newest.Sub(oldest)
+	if span <= 0 {
+		return color256(ageGradient[0])
+	}
+
+	index := int(float64(newest.Sub(r.Date)) / float64(span) * float64(len(ageGradient)-1))
+	return color256(ageGradient[index])
+}
+
+func (bf *BlameFormatter) colorize(text, color string) string {
+	if !bf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+func dateBounds(ranges []models.BlameRange) (time.Time, time.Time) {
+	var oldest, newest time.Time
+	for _, r := range ranges {
+		if !r.IsCommitted() {
+			continue
+		}
+		if oldest.IsZero() || r.Date.Before(oldest) {
+			oldest = r.Date
+		}
+		if newest.IsZero() || r.Date.After(newest) {
+			newest = r.Date
+		}
+	}
+	return oldest, newest
+}
+
+func color256(code int) string {
+	return fmt.Sprintf("\033[38;5;%dm", code)
+}
@@ -1,7 +1,6 @@
 package formatters
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -9,158 +8,100 @@ import (
 	"github.com/DinethDilhara/glo/internal/models"
 )
 
-type BranchFormatter struct{}
-
-func NewBranchFormatter() *BranchFormatter {
-	return &BranchFormatter{}
+func truncateString(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
 }
 
-func (bf *BranchFormatter) FormatJSON(branches []models.Branch) string {
-	data, err := json.MarshalIndent(branches, "", "  ")
-	if err != nil {
-		return "[]"
-	}
-	return string(data)
+// BranchGroup is a named set of branches: the remote-tracking branches of
+// one remote, or the branches sharing a name prefix.
+type BranchGroup struct {
+	Name     string
+	Branches []models.Branch
 }
 
-func (bf *BranchFormatter) FormatTable(branches []models.Branch) string {
-	var result strings.Builder
-	
-	result.WriteString(fmt.Sprintf("%sGit Branches%s\n\n", formatter.ColorBold+formatter.ColorBlue, formatter.ColorReset))
-	
-	result.WriteString(fmt.Sprintf("%-20s %-10s %-15s %-50s %s\n", "Branch", "Type", "Last Commit", "Message", "Author"))
-	result.WriteString(strings.Repeat("-", 120) + "\n")
+// GroupByRemote groups remote-tracking branches by remote, in the order the
+// remotes first appear.
+func GroupByRemote(branches []models.Branch) []BranchGroup {
+	var groups []BranchGroup
+	index := make(map[string]int)
 	
 	for _, branch := range branches {
-		typeColor := formatter.ColorGreen
-		if branch.IsRemote {
-			typeColor = formatter.ColorRed
+		i, ok := index[branch.Remote]
+		if !ok {
+			i = len(groups)
+			index[branch.Remote] = i
+			groups = append(groups, BranchGroup{Name: branch.Remote})
 		}
-		if branch.IsCurrent {
-			typeColor = formatter.ColorYellow
-		}
-		
-		branchType := "local"
-		if branch.IsRemote {
-			branchType = "remote"
-		}
-		if branch.IsCurrent {
-			branchType = "current"
-		}
-		
-		result.WriteString(fmt.Sprintf("%-20s %s%-10s%s %-15s %-50s %s\n",
-			branch.Name,
-			typeColor, branchType, formatter.ColorReset,
-			branch.LastCommitDate,
-			truncateString(branch.LastCommitMessage, 48),
-			branch.LastCommitAuthor))
+		groups[i].Branches = append(groups[i].Branches, branch)
 	}
 	
-	return result.String()
+	return groups
 }
 
-func (bf *BranchFormatter) FormatTree(branches []models.Branch, withDates bool) string {
-	var result strings.Builder
-	
-	result.WriteString(fmt.Sprintf("%sGit Branch Tree%s\n\n", formatter.ColorBold+formatter.ColorBlue, formatter.ColorReset))
-	
-	local := make([]models.Branch, 0)
-	remote := make([]models.Branch, 0)
-	current := ""
+// FormatTracking annotates a branch with its upstream state in the style of
+// git branch -vv, followed by its distance from the default branch, e.g.
+// "[origin/topic: ahead 1] (+3/-0 vs main)". It is empty when there is
+// nothing to show.
+func FormatTracking(branch models.Branch) string {
+	var parts []string
 	
-	for _, branch := range branches {
-		if branch.IsCurrent {
-			current = branch.Name
-		}
-		if branch.IsRemote {
-			remote = append(remote, branch)
-		} else {
-			local = append(local, branch)
+	if upstream := branch.Upstream; upstream != nil {
+		color := formatter.ColorCyan
+		var state []string
+		if upstream.Gone {
+			color = formatter.ColorRed
+			state = append(state, "gone")
 		}
-	}
-	
-	result.WriteString(fmt.Sprintf("%s📁 Repository%s\n", formatter.ColorBold, formatter.ColorReset))
-	result.WriteString("│\n")
-	
-	if len(local) > 0 {
-		result.WriteString(fmt.Sprintf("├── %s🌿 Local Branches%s\n", formatter.ColorGreen, formatter.ColorReset))
-		for i, branch := range local {
-			isLast := i == len(local)-1 && len(remote) == 0
-			prefix := "│   ├── "
-			if isLast {
-				prefix = "│   └── "
-			}
-			
-			branchColor := formatter.ColorGreen
-			indicator := "  "
-			if branch.IsCurrent {
-				branchColor = formatter.ColorYellow
-				indicator = "* "
-			}
-			
-			result.WriteString(fmt.Sprintf("%s%s%s%s%s", prefix, indicator, branchColor, branch.Name, formatter.ColorReset))
-			if withDates {
-				result.WriteString(fmt.Sprintf(" %s(%s)%s", formatter.ColorCyan, branch.LastCommitDate, formatter.ColorReset))
-			}
-			result.WriteString("\n")
+		if upstream.Ahead > 0 {
+			state = append(state, fmt.Sprintf("ahead %d", upstream.Ahead))
 		}
-	}
-	
-	if len(remote) > 0 {
-		if len(local) > 0 {
-			result.WriteString("│\n")
+		if upstream.Behind > 0 {
+			state = append(state, fmt.Sprintf("behind %d", upstream.Behind))
 		}
-		result.WriteString(fmt.Sprintf("└── %s🌐 Remote Branches%s\n", formatter.ColorRed, formatter.ColorReset))
-		for i, branch := range remote {
-			isLast := i == len(remote)-1
-			prefix := "    ├── "
-			if isLast {
-				prefix = "    └── "
-			}
-			
-			result.WriteString(fmt.Sprintf("%s%s%s%s", prefix, formatter.ColorRed, branch.Name, formatter.ColorReset))
-			if withDates {
-				result.WriteString(fmt.Sprintf(" %s(%s)%s", formatter.ColorCyan, branch.LastCommitDate, formatter.ColorReset))
-			}
-			result.WriteString("\n")
+		
+		label := upstream.Name
+		if len(state) > 0 {
+			label += ": " + strings.Join(state, ", ")
 		}
+		parts = append(parts, fmt.Sprintf("%s[%s]%s", color, label, formatter.ColorReset))
 	}
 	
-	result.WriteString("\n")
-	result.WriteString(fmt.Sprintf("%sCurrent branch: %s%s%s\n", formatter.ColorBold, formatter.ColorYellow, current, formatter.ColorReset))
+	if branch.DefaultBranch != "" {
+		parts = append(parts, fmt.Sprintf("%s(%s vs %s)%s", formatter.ColorPurple, FormatDefaultCounts(branch), branch.DefaultBranch, formatter.ColorReset))
+	}
 	
-	return result.String()
+	return strings.Join(parts, " ")
 }
 
-func (bf *BranchFormatter) FormatColor(branches []models.Branch, withDates bool) string {
-	var result strings.Builder
-	
+// FormatDefaultCounts renders the ahead/behind counts against the default
+// branch, or "-" for the default branch itself.
+func FormatDefaultCounts(branch models.Branch) string {
+	if branch.DefaultBranch == "" {
+		return "-"
+	}
+	return fmt.Sprintf("+%d/-%d", branch.AheadDefault, branch.BehindDefault)
+}
+
+// DefaultColumnHeader names the column of FormatDefaultCounts after the
+// default branch, e.g. "vs main".
+func DefaultColumnHeader(branches []models.Branch) string {
 	for _, branch := range branches {
-		prefix := "  "
-		color := formatter.ColorGreen
-		
-		if branch.IsCurrent {
-			prefix = "* "
-			color = formatter.ColorYellow
-		} else if branch.IsRemote {
-			color = formatter.ColorRed
+		if branch.DefaultBranch != "" {
+			return truncateString("vs "+branch.DefaultBranch, 12)
 		}
-		
-		result.WriteString(fmt.Sprintf("%s%s%s%s", prefix, color, branch.Name, formatter.ColorReset))
-		
-		if withDates {
-			result.WriteString(fmt.Sprintf(" %s(%s)%s", formatter.ColorCyan, branch.LastCommitDate, formatter.ColorReset))
-		}
-		
-		result.WriteString("\n")
 	}
-	
-	return result.String()
+	return "Default"
 }
 
-func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+// UpstreamColumnWidth fits a column of FormatUpstream values, up to 40
+// characters.
+func UpstreamColumnWidth(branches []models.Branch) int {
+	width := len("Upstream")
+	for _, branch := range branches {
+		width = max(width, len(FormatUpstream(branch.Upstream)))
 	}
-	return s[:maxLen-3] + "..."
+	return min(width, 40)
 }
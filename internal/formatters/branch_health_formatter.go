@@ -0,0 +1,110 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+var statusColors = map[string]string{
+	models.BranchActive:   formatter.ColorGreen,
+	models.BranchMerged:   formatter.ColorBlue,
+	models.BranchStale:    formatter.ColorYellow,
+	models.BranchDiverged: formatter.ColorRed,
+	models.BranchEmpty:    formatter.ColorCyan,
+}
+
+type BranchHealthFormatter struct {
+	useColor bool
+}
+
+func NewBranchHealthFormatter(useColor bool) *BranchHealthFormatter {
+	return &BranchHealthFormatter{
+		useColor: useColor,
+	}
+}
+
+func (hf *BranchHealthFormatter) FormatJSON(report *models.BranchHealthReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (hf *BranchHealthFormatter) FormatColor(report *models.BranchHealthReport) string {
+	var result strings.Builder
+
+	result.WriteString(hf.colorize("Branch Health", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+	result.WriteString(fmt.Sprintf("Base: %s, stale after %d days\n", report.Base, report.StaleAfterDays))
+	result.WriteString(fmt.Sprintf("%s %d  %s %d  %s %d  %s %d  %s %d\n\n",
+		hf.colorize(models.BranchActive, statusColors[models.BranchActive]), report.Counts[models.BranchActive],
+		hf.colorize(models.BranchMerged, statusColors[models.BranchMerged]), report.Counts[models.BranchMerged],
+		hf.colorize(models.BranchEmpty, statusColors[models.BranchEmpty]), report.Counts[models.BranchEmpty],
+		hf.colorize(models.BranchStale, statusColors[models.BranchStale]), report.Counts[models.BranchStale],
+		hf.colorize(models.BranchDiverged, statusColors[models.BranchDiverged]), report.Counts[models.BranchDiverged]))
+
+	if len(report.Branches) == 0 {
+		result.WriteString("No branches found.\n")
+		return result.String()
+	}
+
+	nameWidth := len("BRANCH")
+	for _, branch := range report.Branches {
+		nameWidth = max(nameWidth, len(branch.Name)+2)
+	}
+	nameWidth = min(nameWidth, 40)
+
+	upstreamWidth := len("UPSTREAM")
+	for _, branch := range report.Branches {
+		upstreamWidth = max(upstreamWidth, len(FormatUpstream(branch.Upstream)))
+	}
+	upstreamWidth = min(upstreamWidth, 40)
+
+	header := fmt.Sprintf("%-9s %-*s %6s %-12s %-*s %s", "STATUS", nameWidth, "BRANCH", "AGE", "BASE", upstreamWidth, "UPSTREAM", "REASONS")
+	result.WriteString(hf.colorize(header, formatter.ColorBold))
+	result.WriteString("\n")
+	result.WriteString(strings.Repeat("-", len(header)+30))
+	result.WriteString("\n")
+
+	for _, branch := range report.Branches {
+		name := "  " + branch.Name
+		if branch.IsCurrent {
+			name = "* " + branch.Name
+		}
+
+		result.WriteString(hf.colorize(fmt.Sprintf("%-9s", branch.Status), statusColors[branch.Status]))
+		result.WriteString(fmt.Sprintf(" %-*s %5dd %-12s %-*s %s\n",
+			nameWidth, truncateString(name, nameWidth),
+			branch.AgeDays,
+			fmt.Sprintf("+%d/-%d", branch.AheadBase, branch.BehindBase),
+			upstreamWidth, truncateString(FormatUpstream(branch.Upstream), upstreamWidth),
+			strings.Join(branch.Reasons, "; ")))
+	}
+
+	return result.String()
+}
+
+func (hf *BranchHealthFormatter) colorize(text, color string) string {
+	if !hf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+// FormatUpstream describes the tracking state of a branch, e.g.
+// "origin/main +1/-0", "origin/topic [gone]", or "-" without an upstream.
+func FormatUpstream(upstream *models.Upstream) string {
+	switch {
+	case upstream == nil:
+		return "-"
+	case upstream.Gone:
+		return upstream.Name + " [gone]"
+	default:
+		return fmt.Sprintf("%s +%d/-%d", upstream.Name, upstream.Ahead, upstream.Behind)
+	}
+}
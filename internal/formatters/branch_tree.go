@@ -0,0 +1,127 @@
+package formatters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// BranchNode is a node of the branch tree view: either a folder of
+// branches sharing a name prefix or a single branch, shown under Label.
+type BranchNode struct {
+	Label    string
+	Branch   *models.Branch
+	Children []*BranchNode
+}
+
+// BranchHierarchy nests branches by the /-separated components of their
+// names without the remote, so feature/auth/login sits below feature and
+// auth. Folders with a single child are collapsed into it: a lone
+// bugfix/crash is one leaf rather than a bugfix folder.
+func BranchHierarchy(branches []models.Branch) []*BranchNode {
+	root := &BranchNode{}
+	for i := range branches {
+		parts := strings.Split(localName(branches[i]), "/")
+
+		node := root
+		for _, part := range parts[:len(parts)-1] {
+			node = node.folder(part)
+		}
+		node.Children = append(node.Children, &BranchNode{
+			Label:  parts[len(parts)-1],
+			Branch: &branches[i],
+		})
+	}
+	return collapseChains(root.Children)
+}
+
+// folder returns the child folder with the given label, adding it if
+// needed.
+func (n *BranchNode) folder(label string) *BranchNode {
+	for _, child := range n.Children {
+		if child.Branch == nil && child.Label == label {
+			return child
+		}
+	}
+	child := &BranchNode{Label: label}
+	n.Children = append(n.Children, child)
+	return child
+}
+
+func collapseChains(nodes []*BranchNode) []*BranchNode {
+	for i, node := range nodes {
+		if node.Branch != nil {
+			continue
+		}
+		node.Children = collapseChains(node.Children)
+		if len(node.Children) == 1 {
+			child := node.Children[0]
+			child.Label = node.Label + "/" + child.Label
+			nodes[i] = child
+		}
+	}
+	return nodes
+}
+
+// GroupByPrefix groups branches by the first component of their name, such
+// as feature/ or release/, in the order the prefixes first appear. Remote
+// branches are grouped by their name without the remote. Branches without a
+// prefix form the group with an empty name.
+func GroupByPrefix(branches []models.Branch) []BranchGroup {
+	var groups []BranchGroup
+	index := make(map[string]int)
+
+	for _, branch := range branches {
+		prefix := ""
+		if first, _, found := strings.Cut(localName(branch), "/"); found {
+			prefix = first + "/"
+		}
+
+		i, ok := index[prefix]
+		if !ok {
+			i = len(groups)
+			index[prefix] = i
+			groups = append(groups, BranchGroup{Name: prefix})
+		}
+		groups[i].Branches = append(groups[i].Branches, branch)
+	}
+
+	return groups
+}
+
+// WriteBranchTree draws nodes below indent with box-drawing connectors.
+// Folders are shown with the number of branches below them; leaf renders
+// branch nodes.
+func WriteBranchTree(result *strings.Builder, nodes []*BranchNode, indent string, leaf func(node *BranchNode) string) {
+	for i, node := range nodes {
+		connector, childIndent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			connector, childIndent = "└── ", "    "
+		}
+
+		if node.Branch != nil {
+			result.WriteString(indent + connector + leaf(node) + "\n")
+			continue
+		}
+
+		result.WriteString(fmt.Sprintf("%s%s%s%s/%s (%d)\n", indent, connector, formatter.ColorBold, node.Label, formatter.ColorReset, countBranches(node)))
+		WriteBranchTree(result, node.Children, indent+childIndent, leaf)
+	}
+}
+
+func countBranches(node *BranchNode) int {
+	if node.Branch != nil {
+		return 1
+	}
+	count := 0
+	for _, child := range node.Children {
+		count += countBranches(child)
+	}
+	return count
+}
+
+func localName(branch models.Branch) string {
+	return strings.TrimPrefix(branch.Name, branch.Remote+"/")
+}
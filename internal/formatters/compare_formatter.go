@@ -0,0 +1,216 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// diffStatWidth is the widest +/- bar of a file in the color diff stat.
+const diffStatWidth = 30
+
+type CompareFormatter struct {
+	useColor bool
+}
+
+func NewCompareFormatter(useColor bool) *CompareFormatter {
+	return &CompareFormatter{
+		useColor: useColor,
+	}
+}
+
+func (cf *CompareFormatter) FormatJSON(comparison *models.Comparison) (string, error) {
+	result := *comparison
+	if result.LeftOnly == nil {
+		result.LeftOnly = []models.Commit{}
+	}
+	if result.RightOnly == nil {
+		result.RightOnly = []models.Commit{}
+	}
+	if result.Files == nil {
+		result.Files = []models.FileChange{}
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (cf *CompareFormatter) FormatColor(comparison *models.Comparison) string {
+	var result strings.Builder
+	commitFormatter := formatter.NewColorFormatter()
+
+	result.WriteString(cf.colorize(fmt.Sprintf("Compare %s...%s", comparison.Left, comparison.Right), formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+	result.WriteString(fmt.Sprintf("Merge base: %s\n", cf.colorize(mergeBaseLabel(comparison), formatter.ColorYellow)))
+	result.WriteString(fmt.Sprintf("Merge:      %s\n", mergeState(comparison)))
+
+	sides := []struct {
+		name, other string
+		count       int
+		commits     []models.Commit
+	}{
+		{comparison.Left, comparison.Right, comparison.LeftCount, comparison.LeftOnly},
+		{comparison.Right, comparison.Left, comparison.RightCount, comparison.RightOnly},
+	}
+	for _, side := range sides {
+		result.WriteString("\n")
+		result.WriteString(cf.colorize(fmt.Sprintf("Only in %s (%d)", side.name, side.count), formatter.ColorBold))
+		result.WriteString("\n")
+		if side.count == 0 {
+			result.WriteString(fmt.Sprintf("  No commits that are not in %s.\n", side.other))
+			continue
+		}
+		result.WriteString(commitFormatter.FormatList(side.commits))
+		result.WriteString("\n")
+		if hidden := side.count - len(side.commits); hidden > 0 {
+			result.WriteString(fmt.Sprintf("  … %d more\n", hidden))
+		}
+	}
+
+	result.WriteString("\n")
+	result.WriteString(cf.colorize(fmt.Sprintf("Files changed on %s (%d, +%d -%d)", comparison.Right, len(comparison.Files), comparison.Additions, comparison.Deletions), formatter.ColorBold))
+	result.WriteString("\n")
+	if len(comparison.Files) == 0 {
+		result.WriteString("  No changes.\n")
+		return result.String()
+	}
+
+	pathWidth, largest := 0, 0
+	for _, change := range comparison.Files {
+		pathWidth = max(pathWidth, len(formatChangePath(change)))
+		largest = max(largest, change.Additions+change.Deletions)
+	}
+	pathWidth = min(pathWidth, 60)
+
+	for _, change := range comparison.Files {
+		result.WriteString(fmt.Sprintf(" %s %-*s | ",
+			cf.colorize(change.Status, statusColor(change.Status)),
+			pathWidth, truncateString(formatChangePath(change), pathWidth)))
+
+		if change.Binary {
+			result.WriteString("binary\n")
+			continue
+		}
+
+		added, deleted := change.Additions, change.Deletions
+		if largest > diffStatWidth {
+			added = scaleBar(added, largest)
+			deleted = scaleBar(deleted, largest)
+		}
+		result.WriteString(fmt.Sprintf("%5d %s%s\n",
+			change.Additions+change.Deletions,
+			cf.colorize(strings.Repeat("+", added), formatter.ColorGreen),
+			cf.colorize(strings.Repeat("-", deleted), formatter.ColorRed)))
+	}
+
+	return result.String()
+}
+
+func (cf *CompareFormatter) FormatMarkdown(comparison *models.Comparison) string {
+	var result strings.Builder
+	commitFormatter := formatter.NewMarkdownFormatter()
+
+	result.WriteString(fmt.Sprintf("# Compare `%s`...`%s`\n\n", comparison.Left, comparison.Right))
+	result.WriteString(fmt.Sprintf("- **Merge base:** `%s`\n", mergeBaseLabel(comparison)))
+	result.WriteString(fmt.Sprintf("- **Merge:** %s\n", mergeState(comparison)))
+	result.WriteString(fmt.Sprintf("- **Only in %s:** %d commits\n", comparison.Left, comparison.LeftCount))
+	result.WriteString(fmt.Sprintf("- **Only in %s:** %d commits\n", comparison.Right, comparison.RightCount))
+	result.WriteString(fmt.Sprintf("- **Files changed:** %d (+%d -%d)\n", len(comparison.Files), comparison.Additions, comparison.Deletions))
+
+	sides := []struct {
+		name    string
+		count   int
+		commits []models.Commit
+	}{
+		{comparison.Left, comparison.LeftCount, comparison.LeftOnly},
+		{comparison.Right, comparison.RightCount, comparison.RightOnly},
+	}
+	for _, side := range sides {
+		if side.count == 0 {
+			continue
+		}
+		result.WriteString(fmt.Sprintf("\n## Only in %s\n\n", side.name))
+		result.WriteString(commitFormatter.FormatCommitTable(side.commits))
+		if hidden := side.count - len(side.commits); hidden > 0 {
+			result.WriteString(fmt.Sprintf("\n*… %d more*\n", hidden))
+		}
+	}
+
+	if len(comparison.Files) > 0 {
+		result.WriteString(fmt.Sprintf("\n## Files changed on %s\n\n", comparison.Right))
+		result.WriteString("| Status | File | Added | Deleted |\n")
+		result.WriteString("|--------|------|-------|---------|\n")
+		for _, change := range comparison.Files {
+			added, deleted := fmt.Sprint(change.Additions), fmt.Sprint(change.Deletions)
+			if change.Binary {
+				added, deleted = "binary", "binary"
+			}
+			result.WriteString(fmt.Sprintf("| %s | `%s` | %s | %s |\n",
+				change.Status, escapeMarkdownCell(formatChangePath(change)), added, deleted))
+		}
+	}
+
+	return result.String()
+}
+
+func (cf *CompareFormatter) colorize(text, color string) string {
+	if !cf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+func mergeBaseLabel(comparison *models.Comparison) string {
+	if comparison.MergeBase == "" {
+		return "none"
+	}
+	return shortHash(comparison.MergeBase)
+}
+
+func mergeState(comparison *models.Comparison) string {
+	switch {
+	case comparison.UpToDate:
+		return fmt.Sprintf("up to date, %s already contains %s", comparison.Left, comparison.Right)
+	case comparison.FastForward:
+		return fmt.Sprintf("fast-forward, %s can move to %s", comparison.Left, comparison.Right)
+	case comparison.MergeBase == "":
+		return "unrelated histories"
+	default:
+		return "diverged, merging needs a merge commit"
+	}
+}
+
+func formatChangePath(change models.FileChange) string {
+	if change.OldPath != "" {
+		return change.OldPath + " => " + change.Path
+	}
+	return change.Path
+}
+
+func statusColor(status string) string {
+	switch status {
+	case "A":
+		return formatter.ColorGreen
+	case "D":
+		return formatter.ColorRed
+	case "R", "C":
+		return formatter.ColorCyan
+	default:
+		return formatter.ColorYellow
+	}
+}
+
+// scaleBar shortens a +/- bar so that the largest change fits
+// diffStatWidth, keeping at least one character for any change.
+func scaleBar(count, largest int) int {
+	if count == 0 {
+		return 0
+	}
+	return max(1, count*diffStatWidth/largest)
+}
@@ -0,0 +1,169 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type ConflictsFormatter struct {
+	useColor  bool
+	showHunks bool
+}
+
+func NewConflictsFormatter(useColor, showHunks bool) *ConflictsFormatter {
+	return &ConflictsFormatter{
+		useColor:  useColor,
+		showHunks: showHunks,
+	}
+}
+
+func (cf *ConflictsFormatter) FormatJSON(report *models.ConflictReport) (string, error) {
+	result := *report
+	result.Results = make([]models.MergePrediction, len(report.Results))
+	for i, prediction := range report.Results {
+		if prediction.Files == nil {
+			prediction.Files = []models.ConflictFile{}
+		}
+		result.Results[i] = prediction
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// FormatColor details the conflicts of a single merge, or summarizes one
+// line per branch when several branches were checked.
+func (cf *ConflictsFormatter) FormatColor(report *models.ConflictReport) string {
+	if len(report.Results) == 1 {
+		return cf.formatPrediction(report.Results[0])
+	}
+
+	var result strings.Builder
+
+	result.WriteString(cf.colorize(fmt.Sprintf("Merge Conflicts into %s", report.Target), formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if len(report.Results) == 0 {
+		result.WriteString("No branches to check.\n")
+		return result.String()
+	}
+
+	nameWidth := len("BRANCH")
+	for _, prediction := range report.Results {
+		nameWidth = max(nameWidth, len(prediction.Branch))
+	}
+	nameWidth = min(nameWidth, 40)
+
+	header := fmt.Sprintf("%-*s %-12s %s", nameWidth, "BRANCH", "RESULT", "FILES")
+	result.WriteString(cf.colorize(header, formatter.ColorBold))
+	result.WriteString("\n")
+	result.WriteString(strings.Repeat("-", len(header)+40))
+	result.WriteString("\n")
+
+	for _, prediction := range report.Results {
+		name := fmt.Sprintf("%-*s", nameWidth, truncateString(prediction.Branch, nameWidth))
+		if prediction.Error != "" {
+			result.WriteString(fmt.Sprintf("%s %s %s\n", name, cf.colorize(fmt.Sprintf("%-12s", "! error"), formatter.ColorYellow), prediction.Error))
+			continue
+		}
+		if prediction.Clean {
+			result.WriteString(fmt.Sprintf("%s %s\n", name, cf.colorize("✓ clean", formatter.ColorGreen)))
+			continue
+		}
+
+		paths := make([]string, len(prediction.Files))
+		for i, file := range prediction.Files {
+			paths[i] = file.Path
+		}
+		result.WriteString(fmt.Sprintf("%s %s %s\n",
+			name,
+			cf.colorize(fmt.Sprintf("%-12s", "✗ "+countNoun(len(prediction.Files), "file")), formatter.ColorRed),
+			strings.Join(paths, ", ")))
+	}
+
+	result.WriteString(fmt.Sprintf("\n%d of %d branches would conflict with %s.\n", report.Conflicting, len(report.Results), report.Target))
+	if report.Failed > 0 {
+		branches := "branches"
+		if report.Failed == 1 {
+			branches = "branch"
+		}
+		result.WriteString(fmt.Sprintf("%d %s could not be checked.\n", report.Failed, branches))
+	}
+	return result.String()
+}
+
+func (cf *ConflictsFormatter) formatPrediction(prediction models.MergePrediction) string {
+	var result strings.Builder
+
+	result.WriteString(cf.colorize(fmt.Sprintf("Merge %s into %s", prediction.Branch, prediction.Target), formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if prediction.Error != "" {
+		result.WriteString(cf.colorize("! Could not merge: "+prediction.Error, formatter.ColorYellow))
+		result.WriteString("\n")
+		return result.String()
+	}
+
+	if prediction.Clean {
+		result.WriteString(cf.colorize("✓ Merges cleanly.", formatter.ColorGreen))
+		result.WriteString("\n")
+		return result.String()
+	}
+
+	result.WriteString(cf.colorize("✗ "+countNoun(len(prediction.Files), "conflicting file"), formatter.ColorRed))
+	result.WriteString("\n")
+
+	for _, file := range prediction.Files {
+		result.WriteString(fmt.Sprintf("\n%s %s",
+			cf.colorize(file.Path, formatter.ColorBold),
+			cf.colorize("("+strings.Join(file.Kinds, ", ")+")", formatter.ColorYellow)))
+		if len(file.Hunks) > 0 {
+			result.WriteString(" " + countNoun(len(file.Hunks), "hunk"))
+		}
+		result.WriteString("\n")
+
+		for _, message := range file.Messages {
+			result.WriteString(fmt.Sprintf("  %s\n", message))
+		}
+
+		for _, hunk := range file.Hunks {
+			result.WriteString(cf.colorize(fmt.Sprintf("  @ line %d: %d ours, %d theirs", hunk.Line, len(hunk.Ours), len(hunk.Theirs)), formatter.ColorCyan))
+			result.WriteString("\n")
+			if !cf.showHunks {
+				continue
+			}
+			for _, line := range hunk.Ours {
+				result.WriteString(cf.colorize("  < "+line, formatter.ColorRed))
+				result.WriteString("\n")
+			}
+			for _, line := range hunk.Theirs {
+				result.WriteString(cf.colorize("  > "+line, formatter.ColorGreen))
+				result.WriteString("\n")
+			}
+		}
+	}
+
+	return result.String()
+}
+
+func (cf *ConflictsFormatter) colorize(text, color string) string {
+	if !cf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+// countNoun writes n with noun, adding an s unless n is 1.
+func countNoun(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
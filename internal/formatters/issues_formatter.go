@@ -0,0 +1,142 @@
+package formatters
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type IssuesFormatter struct {
+	useColor bool
+}
+
+func NewIssuesFormatter(useColor bool) *IssuesFormatter {
+	return &IssuesFormatter{
+		useColor: useColor,
+	}
+}
+
+func (isf *IssuesFormatter) FormatJSON(report *models.IssueReport) (string, error) {
+	result := *report
+	if result.Issues == nil {
+		result.Issues = []models.IssueCommits{}
+	}
+	if result.Unlinked == nil {
+		result.Unlinked = []models.Commit{}
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (isf *IssuesFormatter) FormatColor(report *models.IssueReport) string {
+	var result strings.Builder
+
+	result.WriteString(isf.colorize("Issues", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+	result.WriteString(fmt.Sprintf("%s across %s, %d without an issue\n",
+		countNoun(len(report.Issues), "issue"), countNoun(report.TotalCommits, "commit"), len(report.Unlinked)))
+
+	for _, issue := range report.Issues {
+		result.WriteString("\n")
+		result.WriteString(isf.colorize(issue.Key, formatter.ColorBold+formatter.ColorPurple))
+		result.WriteString(" " + countNoun(len(issue.Commits), "commit"))
+		if issue.URL != "" {
+			result.WriteString(" " + isf.colorize(issue.URL, formatter.ColorBlue))
+		}
+		result.WriteString("\n")
+		for _, commit := range issue.Commits {
+			result.WriteString("  " + isf.formatCommit(commit) + "\n")
+		}
+	}
+
+	if len(report.Unlinked) > 0 {
+		result.WriteString("\n")
+		result.WriteString(isf.colorize(fmt.Sprintf("No issue (%d)", len(report.Unlinked)), formatter.ColorBold+formatter.ColorRed))
+		result.WriteString("\n")
+		for _, commit := range report.Unlinked {
+			result.WriteString("  " + isf.formatCommit(commit) + "\n")
+		}
+	}
+
+	return result.String()
+}
+
+func (isf *IssuesFormatter) FormatMarkdown(report *models.IssueReport) string {
+	var result strings.Builder
+	commitFormatter := formatter.NewMarkdownFormatter()
+
+	result.WriteString("# Issues\n\n")
+	result.WriteString(fmt.Sprintf("- **Commits:** %d\n", report.TotalCommits))
+	result.WriteString(fmt.Sprintf("- **Issues:** %d\n", len(report.Issues)))
+	result.WriteString(fmt.Sprintf("- **Commits without an issue:** %d\n", len(report.Unlinked)))
+
+	for _, issue := range report.Issues {
+		title := issue.Key
+		if issue.URL != "" {
+			title = fmt.Sprintf("[%s](%s)", issue.Key, issue.URL)
+		}
+		result.WriteString(fmt.Sprintf("\n## %s\n\n", title))
+		result.WriteString(commitFormatter.FormatCommitTable(issue.Commits))
+	}
+
+	if len(report.Unlinked) > 0 {
+		result.WriteString("\n## Commits without an issue\n\n")
+		result.WriteString(commitFormatter.FormatCommitTable(report.Unlinked))
+	}
+
+	return result.String()
+}
+
+// FormatCSV writes one row per commit and issue; commits without an issue
+// have an empty issue column.
+func (isf *IssuesFormatter) FormatCSV(report *models.IssueReport) (string, error) {
+	var result strings.Builder
+	writer := csv.NewWriter(&result)
+
+	row := func(key, url string, commit models.Commit) []string {
+		return []string{key, url, commit.Hash, commit.Author, commit.AuthorEmail, commit.Date.Format(time.RFC3339), commit.Message}
+	}
+
+	if err := writer.Write([]string{"issue", "url", "hash", "author", "email", "date", "subject"}); err != nil {
+		return "", err
+	}
+	for _, issue := range report.Issues {
+		for _, commit := range issue.Commits {
+			if err := writer.Write(row(issue.Key, issue.URL, commit)); err != nil {
+				return "", err
+			}
+		}
+	}
+	for _, commit := range report.Unlinked {
+		if err := writer.Write(row("", "", commit)); err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+	return result.String(), writer.Error()
+}
+
+func (isf *IssuesFormatter) formatCommit(commit models.Commit) string {
+	return fmt.Sprintf("%s %s %s %s",
+		isf.colorize(shortHash(commit.Hash), formatter.ColorYellow),
+		isf.colorize(commit.Author, formatter.ColorGreen),
+		isf.colorize(formatter.FormatDate(commit.Date), formatter.ColorCyan),
+		commit.Message)
+}
+
+func (isf *IssuesFormatter) colorize(text, color string) string {
+	if !isf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
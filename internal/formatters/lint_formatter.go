@@ -0,0 +1,178 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type LintFormatter struct {
+	useColor bool
+}
+
+func NewLintFormatter(useColor bool) *LintFormatter {
+	return &LintFormatter{
+		useColor: useColor,
+	}
+}
+
+func (lf *LintFormatter) FormatJSON(report *models.LintReport) (string, error) {
+	result := *report
+	if result.Results == nil {
+		result.Results = []models.LintResult{}
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (lf *LintFormatter) FormatColor(report *models.LintReport) string {
+	var result strings.Builder
+
+	for _, lintResult := range report.Results {
+		if lintResult.Hash != "" {
+			result.WriteString(lf.colorize(shortHash(lintResult.Hash), formatter.ColorYellow) + " ")
+		}
+		result.WriteString(lf.colorize(lintResult.Subject, formatter.ColorBold))
+		result.WriteString("\n")
+
+		for _, violation := range lintResult.Violations {
+			mark, color := "✗", formatter.ColorRed
+			if violation.Level == models.LevelWarning {
+				mark, color = "!", formatter.ColorYellow
+			}
+			result.WriteString(fmt.Sprintf("  %s %s\n",
+				lf.colorize(fmt.Sprintf("%s %-17s", mark, violation.Rule), color),
+				violation.Message))
+		}
+		result.WriteString("\n")
+	}
+
+	checked := countNoun(report.Checked, "message")
+	if len(report.Results) == 0 {
+		result.WriteString(lf.colorize(fmt.Sprintf("✓ %s checked, no problems.", checked), formatter.ColorGreen))
+		result.WriteString("\n")
+		return result.String()
+	}
+
+	summary := fmt.Sprintf("%s checked: %s, %s.", checked, countNoun(report.Errors, "error"), countNoun(report.Warnings, "warning"))
+	color := formatter.ColorYellow
+	if report.Errors > 0 {
+		color = formatter.ColorRed
+	}
+	result.WriteString(lf.colorize(summary, color))
+	result.WriteString("\n")
+	return result.String()
+}
+
+// The subset of SARIF 2.1.0 that FormatSARIF writes.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string           `json:"id"`
+	ShortDescription     sarifMessage     `json:"shortDescription"`
+	DefaultConfiguration sarifRuleDefault `json:"defaultConfiguration"`
+}
+
+type sarifRuleDefault struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// FormatSARIF reports violations as a SARIF log for code scanning tools.
+// Commits have no file location, so each result points at its commit as a
+// logical location.
+func (lf *LintFormatter) FormatSARIF(report *models.LintReport) (string, error) {
+	driver := sarifDriver{
+		Name:           "glo lint",
+		InformationURI: "https://github.com/DinethDilhara/glo",
+		Rules:          []sarifRule{},
+	}
+	for _, rule := range report.Rules {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifRuleDefault{Level: rule.Level},
+		})
+	}
+
+	results := []sarifResult{}
+	for _, lintResult := range report.Results {
+		for _, violation := range lintResult.Violations {
+			sarif := sarifResult{
+				RuleID:  violation.Rule,
+				Level:   violation.Level,
+				Message: sarifMessage{Text: fmt.Sprintf("%s: %s", lintResult.Subject, violation.Message)},
+			}
+			if lintResult.Hash != "" {
+				sarif.Locations = []sarifLocation{{
+					LogicalLocations: []sarifLogicalLocation{{Name: lintResult.Hash, Kind: "commit"}},
+				}}
+			}
+			results = append(results, sarif)
+		}
+	}
+
+	log := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (lf *LintFormatter) colorize(text, color string) string {
+	if !lf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
@@ -50,7 +50,14 @@ func (lf *LogFormatter) FormatMarkdown(commits []models.Commit) string {
 		result.WriteString(fmt.Sprintf("### %d. %s\n\n", i+1, commit.Message))
 		result.WriteString(fmt.Sprintf("- **Hash:** `%s`\n", commit.Hash[:8]))
 		result.WriteString(fmt.Sprintf("- **Author:** %s\n", commit.Author))
-		result.WriteString(fmt.Sprintf("- **Date:** %s\n\n", commit.Date))
+		result.WriteString(fmt.Sprintf("- **Date:** %s\n", formatter.FormatDate(commit.Date)))
+		if commit.IsMerge() {
+			result.WriteString("- **Merge:** yes\n")
+		}
+		if commit.Signature != nil {
+			result.WriteString(fmt.Sprintf("- **Signature:** %s\n", formatter.FormatSignature(commit.Signature)))
+		}
+		result.WriteString("\n")
 		
 		if i < len(commits)-1 {
 			result.WriteString("---\n\n")
@@ -66,7 +73,13 @@ func (lf *LogFormatter) FormatColor(commits []models.Commit) string {
 	for i, commit := range commits {
 		result.WriteString(fmt.Sprintf("%s%s%s ", formatter.ColorYellow, commit.Hash[:8], formatter.ColorReset))
 		result.WriteString(fmt.Sprintf("%s%s%s ", formatter.ColorGreen, commit.Author, formatter.ColorReset))
-		result.WriteString(fmt.Sprintf("%s%s%s ", formatter.ColorCyan, commit.Date, formatter.ColorReset))
+		result.WriteString(fmt.Sprintf("%s%s%s ", formatter.ColorCyan, formatter.FormatDate(commit.Date), formatter.ColorReset))
+		if commit.IsMerge() {
+			result.WriteString(fmt.Sprintf("%s[merge]%s ", formatter.ColorPurple, formatter.ColorReset))
+		}
+		if commit.Signature != nil {
+			result.WriteString(fmt.Sprintf("%s[%s]%s ", formatter.SignatureColor(commit.Signature), commit.Signature.Label(), formatter.ColorReset))
+		}
 		result.WriteString(commit.Message)
 		
 		if i < len(commits)-1 {
@@ -83,14 +96,9 @@ func (lf *LogFormatter) FormatColorSummary(commits []models.Commit) string {
 	result.WriteString(fmt.Sprintf("%sGit Repository Summary%s\n\n", formatter.ColorBold+formatter.ColorBlue, formatter.ColorReset))
 	result.WriteString(fmt.Sprintf("Total commits: %d\n\n", len(commits)))
 	
-	authorCount := make(map[string]int)
-	for _, commit := range commits {
-		authorCount[commit.Author]++
-	}
-	
 	result.WriteString(fmt.Sprintf("%sCommits by Author:%s\n", formatter.ColorBold+formatter.ColorBlue, formatter.ColorReset))
-	for author, count := range authorCount {
-		result.WriteString(fmt.Sprintf("  %s: %d commits\n", author, count))
+	for _, author := range models.CountCommitsByAuthor(commits, false).Ranked() {
+		result.WriteString(fmt.Sprintf("  %s: %d commits\n", author.Author, author.Count))
 	}
 	
 	result.WriteString(fmt.Sprintf("\n%sRecent Commits:%s\n", formatter.ColorBold+formatter.ColorBlue, formatter.ColorReset))
@@ -105,7 +113,7 @@ func (lf *LogFormatter) FormatColorSummary(commits []models.Commit) string {
 			i+1,
 			formatter.ColorYellow, commit.Hash[:8], formatter.ColorReset,
 			formatter.ColorGreen, commit.Author, formatter.ColorReset,
-			formatter.ColorCyan, commit.Date, formatter.ColorReset,
+			formatter.ColorCyan, formatter.FormatDate(commit.Date), formatter.ColorReset,
 			commit.Message))
 	}
 	
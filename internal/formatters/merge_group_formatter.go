@@ -0,0 +1,128 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type MergeGroupFormatter struct {
+	useColor bool
+}
+
+func NewMergeGroupFormatter(useColor bool) *MergeGroupFormatter {
+	return &MergeGroupFormatter{
+		useColor: useColor,
+	}
+}
+
+func (mf *MergeGroupFormatter) FormatJSON(groups []models.MergeGroup) (string, error) {
+	result := make([]models.MergeGroup, len(groups))
+	for i, group := range groups {
+		if group.Merged == nil {
+			group.Merged = []models.Commit{}
+		}
+		result[i] = group
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// FormatColor lists the first-parent history with the commits each merge
+// brought in drawn below it.
+func (mf *MergeGroupFormatter) FormatColor(groups []models.MergeGroup) string {
+	var result strings.Builder
+
+	for i, group := range groups {
+		if i > 0 && (group.Commit.IsMerge() || groups[i-1].Commit.IsMerge()) {
+			result.WriteString("\n")
+		}
+
+		result.WriteString(mf.formatCommit(group.Commit))
+		if group.Commit.IsMerge() {
+			result.WriteString(" " + mf.colorize(fmt.Sprintf("[merge, %s]", countNoun(len(group.Merged), "commit")), formatter.ColorPurple))
+		}
+		result.WriteString("\n")
+
+		for j, commit := range group.Merged {
+			branch := "├─ "
+			if j == len(group.Merged)-1 {
+				branch = "└─ "
+			}
+			result.WriteString("  " + mf.colorize(branch, formatter.ColorPurple) + mf.formatCommit(commit) + "\n")
+		}
+	}
+
+	return result.String()
+}
+
+func (mf *MergeGroupFormatter) FormatMarkdown(groups []models.MergeGroup) string {
+	var result strings.Builder
+	commitFormatter := formatter.NewMarkdownFormatter()
+
+	merges := 0
+	for _, group := range groups {
+		if group.Commit.IsMerge() {
+			merges++
+		}
+	}
+
+	result.WriteString("# Merge History\n\n")
+	result.WriteString(fmt.Sprintf("**Merges:** %d, **Direct commits:** %d\n", merges, len(groups)-merges))
+
+	for _, group := range groups {
+		commit := group.Commit
+		result.WriteString(fmt.Sprintf("\n## %s\n\n", commit.Message))
+
+		kind := "Commit"
+		if commit.IsMerge() {
+			kind = "Merge"
+		}
+		result.WriteString(fmt.Sprintf("- **%s:** `%s` by %s, %s\n", kind, shortHash(commit.Hash), commit.Author, formatter.FormatDate(commit.Date)))
+		if commit.Signature != nil {
+			result.WriteString(fmt.Sprintf("- **Signature:** %s\n", formatter.FormatSignature(commit.Signature)))
+		}
+		if len(commit.References) > 0 {
+			result.WriteString(fmt.Sprintf("- **References:** %s\n", formatter.FormatReferenceLinks(commit.References)))
+		}
+
+		if !commit.IsMerge() {
+			continue
+		}
+		if len(group.Merged) == 0 {
+			result.WriteString("\n*No commits brought in.*\n")
+			continue
+		}
+		result.WriteString("\n")
+		result.WriteString(commitFormatter.FormatCommitTable(group.Merged))
+	}
+
+	return result.String()
+}
+
+func (mf *MergeGroupFormatter) formatCommit(commit models.Commit) string {
+	signature := ""
+	if commit.Signature != nil {
+		signature = mf.colorize("["+commit.Signature.Label()+"]", formatter.SignatureColor(commit.Signature)) + " "
+	}
+	return fmt.Sprintf("%s %s %s %s%s",
+		mf.colorize(shortHash(commit.Hash), formatter.ColorYellow),
+		mf.colorize(commit.Author, formatter.ColorGreen),
+		mf.colorize(formatter.FormatDate(commit.Date), formatter.ColorCyan),
+		signature,
+		commit.Message)
+}
+
+func (mf *MergeGroupFormatter) colorize(text, color string) string {
+	if !mf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
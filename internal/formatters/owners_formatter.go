@@ -0,0 +1,162 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type OwnersFormatter struct {
+	useColor bool
+	top      int
+}
+
+// NewOwnersFormatter returns a formatter listing at most top owners per
+// directory in the table and markdown output.
+func NewOwnersFormatter(useColor bool, top int) *OwnersFormatter {
+	return &OwnersFormatter{
+		useColor: useColor,
+		top:      top,
+	}
+}
+
+func (of *OwnersFormatter) FormatJSON(report *models.OwnershipReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (of *OwnersFormatter) FormatTable(report *models.OwnershipReport) string {
+	var result strings.Builder
+
+	result.WriteString(of.colorize("Code Ownership", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+	result.WriteString(fmt.Sprintf("%d files, %d lines", report.TotalFiles, report.TotalLines))
+	if report.CodeOwnersFile != "" {
+		result.WriteString(fmt.Sprintf(", compared with %s", report.CodeOwnersFile))
+	}
+	result.WriteString("\n\n")
+
+	if len(report.Directories) == 0 {
+		result.WriteString("No files found.\n")
+		return result.String()
+	}
+
+	pathWidth := len("DIRECTORY")
+	for _, directory := range report.Directories {
+		pathWidth = max(pathWidth, len(directory.Path))
+	}
+	pathWidth = min(pathWidth, 40)
+
+	header := fmt.Sprintf("%-*s %6s %8s  %s", pathWidth, "DIRECTORY", "FILES", "LINES", "OWNERS")
+	result.WriteString(of.colorize(header, formatter.ColorBold))
+	result.WriteString("\n")
+	result.WriteString(strings.Repeat("-", len(header)+40))
+	result.WriteString("\n")
+
+	for _, directory := range report.Directories {
+		result.WriteString(fmt.Sprintf("%-*s %6d %8d  %s\n",
+			pathWidth, truncateString(directory.Path, pathWidth),
+			directory.Files, directory.TotalLines,
+			of.formatShares(directory.Owners)))
+
+		indent := strings.Repeat(" ", pathWidth+18)
+		if len(directory.CodeOwners) > 0 {
+			result.WriteString(fmt.Sprintf("%sCODEOWNERS: %s\n", indent, strings.Join(directory.CodeOwners, " ")))
+		}
+		for _, issue := range ownershipIssues(directory) {
+			result.WriteString(indent)
+			result.WriteString(of.colorize("⚠ "+issue, formatter.ColorYellow))
+			result.WriteString("\n")
+		}
+	}
+
+	result.WriteString("\n")
+	result.WriteString(of.colorize("Overall:", formatter.ColorBold))
+	result.WriteString(" ")
+	result.WriteString(of.formatShares(report.Owners))
+	result.WriteString("\n")
+
+	return result.String()
+}
+
+func (of *OwnersFormatter) FormatMarkdown(report *models.OwnershipReport) string {
+	var result strings.Builder
+
+	result.WriteString("# Code Ownership\n\n")
+	result.WriteString(fmt.Sprintf("**Files:** %d  \n", report.TotalFiles))
+	result.WriteString(fmt.Sprintf("**Lines:** %d  \n", report.TotalLines))
+	if report.CodeOwnersFile != "" {
+		result.WriteString(fmt.Sprintf("**CODEOWNERS:** `%s`  \n", report.CodeOwnersFile))
+	}
+	result.WriteString("\n")
+
+	if report.CodeOwnersFile != "" {
+		result.WriteString("| Directory | Files | Lines | Owners | CODEOWNERS | Issues |\n")
+		result.WriteString("|-----------|-------|-------|--------|------------|--------|\n")
+	} else {
+		result.WriteString("| Directory | Files | Lines | Owners |\n")
+		result.WriteString("|-----------|-------|-------|--------|\n")
+	}
+
+	for _, directory := range report.Directories {
+		result.WriteString(fmt.Sprintf("| `%s` | %d | %d | %s |",
+			directory.Path, directory.Files, directory.TotalLines,
+			escapeMarkdownCell(of.formatShares(directory.Owners))))
+		if report.CodeOwnersFile != "" {
+			result.WriteString(fmt.Sprintf(" %s | %s |",
+				escapeMarkdownCell(strings.Join(directory.CodeOwners, " ")),
+				escapeMarkdownCell(strings.Join(ownershipIssues(directory), "; "))))
+		}
+		result.WriteString("\n")
+	}
+
+	result.WriteString("\n## Overall\n\n")
+	for _, share := range report.Owners {
+		result.WriteString(fmt.Sprintf("- **%s**: %d lines (%.1f%%)\n", share.Author, share.Lines, share.Percent))
+	}
+
+	return result.String()
+}
+
+func (of *OwnersFormatter) formatShares(shares []models.AuthorShare) string {
+	var parts []string
+	for i, share := range shares {
+		if of.top > 0 && i == of.top {
+			parts = append(parts, fmt.Sprintf("+%d more", len(shares)-of.top))
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s %.0f%%", share.Author, share.Percent))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (of *OwnersFormatter) colorize(text, color string) string {
+	if !of.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+func ownershipIssues(directory models.DirectoryOwnership) []string {
+	var issues []string
+	if directory.UnownedFiles > 0 {
+		issues = append(issues, fmt.Sprintf("%d file(s) without CODEOWNERS entry", directory.UnownedFiles))
+	}
+	if len(directory.StaleOwners) > 0 {
+		issues = append(issues, "stale: "+strings.Join(directory.StaleOwners, ", "))
+	}
+	if len(directory.MissingOwners) > 0 {
+		issues = append(issues, "missing: "+strings.Join(directory.MissingOwners, ", "))
+	}
+	return issues
+}
+
+func escapeMarkdownCell(text string) string {
+	return strings.ReplaceAll(text, "|", "\\|")
+}
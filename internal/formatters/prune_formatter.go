@@ -0,0 +1,116 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type PruneFormatter struct {
+	useColor bool
+}
+
+func NewPruneFormatter(useColor bool) *PruneFormatter {
+	return &PruneFormatter{
+		useColor: useColor,
+	}
+}
+
+func (pf *PruneFormatter) FormatJSON(target string, candidates []models.PruneCandidate, protected []string, deleted bool) (string, error) {
+	if candidates == nil {
+		candidates = []models.PruneCandidate{}
+	}
+	if protected == nil {
+		protected = []string{}
+	}
+	result := map[string]interface{}{
+		"target":     target,
+		"dry_run":    !deleted,
+		"candidates": candidates,
+		"protected":  protected,
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (pf *PruneFormatter) FormatTable(target string, candidates []models.PruneCandidate, protected []string) string {
+	var result strings.Builder
+
+	result.WriteString(pf.colorize("Branch Prune", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n")
+	result.WriteString(fmt.Sprintf("Target: %s\n\n", target))
+
+	if len(candidates) == 0 {
+		result.WriteString("Nothing to prune.\n")
+	} else {
+		nameWidth := len("BRANCH")
+		for _, candidate := range candidates {
+			nameWidth = max(nameWidth, len(candidate.Name))
+		}
+		nameWidth = min(nameWidth, 40)
+
+		header := fmt.Sprintf("%-*s %-10s %6s  %s", nameWidth, "BRANCH", "TIP", "AGE", "REASONS")
+		result.WriteString(pf.colorize(header, formatter.ColorBold))
+		result.WriteString("\n")
+		result.WriteString(strings.Repeat("-", len(header)+30))
+		result.WriteString("\n")
+
+		for _, candidate := range candidates {
+			result.WriteString(fmt.Sprintf("%s %s %5dd  %s\n",
+				pf.colorize(fmt.Sprintf("%-*s", nameWidth, truncateString(candidate.Name, nameWidth)), formatter.ColorRed),
+				pf.colorize(fmt.Sprintf("%-10s", shortHash(candidate.Hash)), formatter.ColorYellow),
+				candidate.AgeDays,
+				strings.Join(candidate.Reasons, "; ")))
+		}
+	}
+
+	if len(protected) > 0 {
+		result.WriteString(fmt.Sprintf("\nProtected, not pruned: %s\n", strings.Join(protected, ", ")))
+	}
+
+	return result.String()
+}
+
+func (pf *PruneFormatter) FormatHistory(history []models.PrunedBranch) string {
+	var result strings.Builder
+
+	result.WriteString(pf.colorize("Pruned Branches", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if len(history) == 0 {
+		result.WriteString("No branches have been pruned.\n")
+		return result.String()
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		pruned := history[i]
+		result.WriteString(fmt.Sprintf("%s %s %s  %s\n",
+			pf.colorize(shortHash(pruned.Hash), formatter.ColorYellow),
+			pf.colorize(pruned.Name, formatter.ColorGreen),
+			pf.colorize(formatter.FormatDate(pruned.DeletedAt), formatter.ColorCyan),
+			strings.Join(pruned.Reasons, "; ")))
+	}
+
+	return result.String()
+}
+
+func (pf *PruneFormatter) colorize(text, color string) string {
+	if !pf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 8 {
+		return hash[:8]
+	}
+	return hash
+}
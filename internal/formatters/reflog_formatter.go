@@ -0,0 +1,117 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// reflogActionColors colors the actions that move history around the most.
+// Other actions are printed uncolored.
+var reflogActionColors = map[string]string{
+	models.ReflogCommit:   formatter.ColorGreen,
+	models.ReflogRebase:   formatter.ColorPurple,
+	models.ReflogReset:    formatter.ColorRed,
+	models.ReflogCheckout: formatter.ColorCyan,
+	models.ReflogMerge:    formatter.ColorBlue,
+	models.ReflogPull:     formatter.ColorBlue,
+}
+
+type ReflogFormatter struct {
+	useColor bool
+}
+
+func NewReflogFormatter(useColor bool) *ReflogFormatter {
+	return &ReflogFormatter{
+		useColor: useColor,
+	}
+}
+
+func (rf *ReflogFormatter) FormatJSON(reflog *models.Reflog) (string, error) {
+	result := *reflog
+	if result.Entries == nil {
+		result.Entries = []models.ReflogEntry{}
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (rf *ReflogFormatter) FormatColor(reflog *models.Reflog) string {
+	var result strings.Builder
+
+	result.WriteString(rf.colorize("Reflog: "+reflog.Ref, formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if len(reflog.Entries) == 0 {
+		result.WriteString("No reflog entries found.\n")
+		return result.String()
+	}
+
+	width := 0
+	for _, entry := range reflog.Entries {
+		width = max(width, len(entry.Selector))
+	}
+
+	for _, entry := range reflog.Entries {
+		result.WriteString(fmt.Sprintf("%s %s %s %s\n",
+			rf.colorize(fmt.Sprintf("%-*s", width, entry.Selector), formatter.ColorBold),
+			rf.colorize(shortHash(entry.NewHash), formatter.ColorYellow),
+			rf.colorize(formatter.FormatDate(entry.Date), formatter.ColorCyan),
+			rf.formatMessage(entry)))
+	}
+
+	entries := "1 entry"
+	if len(reflog.Entries) != 1 {
+		entries = fmt.Sprintf("%d entries", len(reflog.Entries))
+	}
+	result.WriteString(fmt.Sprintf("\n%s. Restore one with: glo reflog recover <entry>\n", entries))
+	return result.String()
+}
+
+func (rf *ReflogFormatter) FormatMarkdown(reflog *models.Reflog) string {
+	var result strings.Builder
+
+	result.WriteString(fmt.Sprintf("# Reflog: %s\n\n", reflog.Ref))
+	if len(reflog.Entries) == 0 {
+		result.WriteString("No reflog entries found.\n")
+		return result.String()
+	}
+
+	result.WriteString("| Entry | From | To | Date | Action | Message |\n")
+	result.WriteString("|-------|------|----|------|--------|---------|\n")
+	for _, entry := range reflog.Entries {
+		result.WriteString(fmt.Sprintf("| `%s` | `%s` | `%s` | %s | %s | %s |\n",
+			entry.Selector,
+			shortHash(entry.OldHash),
+			shortHash(entry.NewHash),
+			formatter.FormatDate(entry.Date),
+			entry.Action,
+			escapeMarkdownCell(entry.Message)))
+	}
+	return result.String()
+}
+
+// formatMessage colors the "action (detail):" prefix of a reflog message by
+// its action.
+func (rf *ReflogFormatter) formatMessage(entry models.ReflogEntry) string {
+	color, ok := reflogActionColors[entry.Action]
+	prefix, rest, found := strings.Cut(entry.Message, ":")
+	if !ok || !found {
+		return entry.Message
+	}
+	return rf.colorize(prefix+":", color) + rest
+}
+
+func (rf *ReflogFormatter) colorize(text, color string) string {
+	if !rf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
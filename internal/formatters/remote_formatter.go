@@ -0,0 +1,83 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type RemoteFormatter struct {
+	useColor bool
+}
+
+func NewRemoteFormatter(useColor bool) *RemoteFormatter {
+	return &RemoteFormatter{
+		useColor: useColor,
+	}
+}
+
+func (rf *RemoteFormatter) FormatJSON(remotes []models.Remote) (string, error) {
+	if remotes == nil {
+		remotes = []models.Remote{}
+	}
+	data, err := json.MarshalIndent(remotes, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (rf *RemoteFormatter) FormatColor(remotes []models.Remote) string {
+	var result strings.Builder
+
+	result.WriteString(rf.colorize("Git Remotes", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if len(remotes) == 0 {
+		result.WriteString("No remotes configured.\n")
+		return result.String()
+	}
+
+	nameWidth := len("REMOTE")
+	for _, remote := range remotes {
+		nameWidth = max(nameWidth, len(remote.Name))
+	}
+
+	header := fmt.Sprintf("%-*s %8s %8s  %-10s %s", nameWidth, "REMOTE", "BRANCHES", "TRACKING", "HEAD", "URL")
+	result.WriteString(rf.colorize(header, formatter.ColorBold))
+	result.WriteString("\n")
+	result.WriteString(strings.Repeat("-", len(header)+40))
+	result.WriteString("\n")
+
+	for _, remote := range remotes {
+		head := remote.HeadBranch
+		if head == "" {
+			head = "-"
+		}
+
+		result.WriteString(fmt.Sprintf("%s %8d %8d  %-10s %s\n",
+			rf.colorize(fmt.Sprintf("%-*s", nameWidth, remote.Name), formatter.ColorGreen),
+			remote.Branches, remote.Tracking,
+			truncateString(head, 10),
+			rf.colorize(remote.FetchURL, formatter.ColorCyan)))
+
+		if remote.PushURL != "" && remote.PushURL != remote.FetchURL {
+			result.WriteString(fmt.Sprintf("%*s%s %s\n",
+				nameWidth+1+8+1+8+2+10+1, "",
+				rf.colorize(remote.PushURL, formatter.ColorCyan),
+				rf.colorize("(push)", formatter.ColorYellow)))
+		}
+	}
+
+	return result.String()
+}
+
+func (rf *RemoteFormatter) colorize(text, color string) string {
+	if !rf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
@@ -30,6 +30,11 @@ func (sf *StatusFormatter) FormatColor(status *models.RepositoryStatus) string {
 		result.WriteString("\n")
 	}
 	
+	if len(status.Hooks) > 0 {
+		result.WriteString(sf.formatHooks(status))
+		result.WriteString("\n")
+	}
+	
 	if status.IsClean {
 		cleanMsg := "Working tree clean"
 		if sf.useColor {
@@ -78,6 +83,10 @@ func (sf *StatusFormatter) FormatTable(status *models.RepositoryStatus) string {
 	
 	result.WriteString(sf.formatHeader(status))
 	result.WriteString("\n")
+	if len(status.Hooks) > 0 {
+		result.WriteString(sf.formatHooks(status))
+		result.WriteString("\n")
+	}
 	result.WriteString(strings.Repeat("─", 50))
 	result.WriteString("\n")
 	
@@ -171,6 +180,23 @@ func (sf *StatusFormatter) formatRemoteInfo(status *models.RepositoryStatus) str
 	return fmt.Sprintf("%s (%s)", remote, strings.Join(parts, ", "))
 }
 
+// formatHooks lists the installed git hooks, marking those glo manages.
+func (sf *StatusFormatter) formatHooks(status *models.RepositoryStatus) string {
+	names := make([]string, len(status.Hooks))
+	for i, hook := range status.Hooks {
+		names[i] = hook.Name
+		if hook.Managed {
+			names[i] += " (glo)"
+		}
+	}
+	
+	label := "Hooks: "
+	if sf.useColor {
+		label = sf.colorize(label, formatter.ColorCyan)
+	}
+	return label + strings.Join(names, ", ")
+}
+
 func (sf *StatusFormatter) formatFileSection(title string, files []models.FileStatus, sectionColor string) string {
 	var result strings.Builder
 	
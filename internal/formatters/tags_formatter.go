@@ -0,0 +1,88 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type TagsFormatter struct {
+	useColor bool
+}
+
+func NewTagsFormatter(useColor bool) *TagsFormatter {
+	return &TagsFormatter{
+		useColor: useColor,
+	}
+}
+
+func (tf *TagsFormatter) FormatJSON(tags []models.Tag) (string, error) {
+	if tags == nil {
+		tags = []models.Tag{}
+	}
+	data, err := json.MarshalIndent(tags, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (tf *TagsFormatter) FormatColor(tags []models.Tag) string {
+	var result strings.Builder
+
+	result.WriteString(tf.colorize("Git Tags", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+
+	if len(tags) == 0 {
+		result.WriteString("No tags found.\n")
+		return result.String()
+	}
+
+	nameWidth := len("TAG")
+	for _, tag := range tags {
+		nameWidth = max(nameWidth, len(tag.Name))
+	}
+	nameWidth = min(nameWidth, 40)
+
+	header := fmt.Sprintf("%-*s %-8s %-10s %-24s %s", nameWidth, "TAG", "COMMIT", "DATE", "SIGNATURE", "SUBJECT")
+	result.WriteString(tf.colorize(header, formatter.ColorBold))
+	result.WriteString("\n")
+	result.WriteString(strings.Repeat("-", len(header)+40))
+	result.WriteString("\n")
+
+	unsigned := 0
+	for _, tag := range tags {
+		if !tag.HasValidSignature() {
+			unsigned++
+		}
+
+		signature, color := "unsigned", formatter.ColorYellow
+		if !tag.Annotated {
+			signature = "lightweight"
+		}
+		if tag.Signature != nil {
+			signature, color = tag.Signature.Label(), formatter.SignatureColor(tag.Signature)
+		}
+
+		line := fmt.Sprintf("%s %s %s %s %s",
+			tf.colorize(fmt.Sprintf("%-*s", nameWidth, truncateString(tag.Name, nameWidth)), formatter.ColorGreen),
+			tf.colorize(shortHash(tag.Commit), formatter.ColorYellow),
+			tf.colorize(tag.Date.Format("2006-01-02"), formatter.ColorCyan),
+			tf.colorize(fmt.Sprintf("%-24s", signature), color),
+			tag.Subject)
+		result.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+
+	result.WriteString(fmt.Sprintf("\n%d of %d tags without a good signature.\n", unsigned, len(tags)))
+	return result.String()
+}
+
+func (tf *TagsFormatter) colorize(text, color string) string {
+	if !tf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
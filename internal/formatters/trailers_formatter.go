@@ -0,0 +1,98 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/formatter"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type TrailersFormatter struct {
+	useColor bool
+}
+
+func NewTrailersFormatter(useColor bool) *TrailersFormatter {
+	return &TrailersFormatter{
+		useColor: useColor,
+	}
+}
+
+func (tf *TrailersFormatter) FormatJSON(report *models.TrailerReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (tf *TrailersFormatter) FormatColor(report *models.TrailerReport) string {
+	var result strings.Builder
+
+	result.WriteString(tf.colorize("Trailer Usage", formatter.ColorBold+formatter.ColorBlue))
+	result.WriteString("\n\n")
+	result.WriteString(fmt.Sprintf("Commits: %d, with trailers: %d\n\n", report.TotalCommits, report.CommitsWithTrailers))
+
+	if len(report.Keys) == 0 {
+		result.WriteString("No trailers found.\n")
+		return result.String()
+	}
+
+	for _, usage := range report.Keys {
+		width := int(usage.Percent * barChartWidth / 100)
+		if width == 0 && usage.Commits > 0 {
+			width = 1
+		}
+		result.WriteString(fmt.Sprintf("%s %d commits (%.1f%%), %d lines %s\n",
+			tf.colorize(usage.Key, formatter.ColorBold+formatter.ColorCyan),
+			usage.Commits, usage.Percent, usage.Occurrences,
+			tf.colorize(strings.Repeat("█", width), formatter.ColorGreen)))
+
+		for _, value := range usage.Values {
+			result.WriteString(fmt.Sprintf("  %5d  %s\n", value.Count, formatTrailerValue(value)))
+		}
+		if hidden := usage.DistinctValues - len(usage.Values); hidden > 0 {
+			result.WriteString(fmt.Sprintf("         … %d more\n", hidden))
+		}
+		result.WriteString("\n")
+	}
+
+	return result.String()
+}
+
+func (tf *TrailersFormatter) FormatMarkdown(report *models.TrailerReport) string {
+	var result strings.Builder
+
+	result.WriteString("# Trailer Usage\n\n")
+	result.WriteString(fmt.Sprintf("**Commits:** %d  \n", report.TotalCommits))
+	result.WriteString(fmt.Sprintf("**With Trailers:** %d\n\n", report.CommitsWithTrailers))
+
+	result.WriteString("| Trailer | Commits | % | Lines | Top Values |\n")
+	result.WriteString("|---------|---------|---|-------|------------|\n")
+	for _, usage := range report.Keys {
+		var values []string
+		for _, value := range usage.Values {
+			values = append(values, fmt.Sprintf("%s (%d)", formatTrailerValue(value), value.Count))
+		}
+		result.WriteString(fmt.Sprintf("| `%s` | %d | %.1f%% | %d | %s |\n",
+			usage.Key, usage.Commits, usage.Percent, usage.Occurrences,
+			escapeMarkdownCell(strings.Join(values, ", "))))
+	}
+
+	return result.String()
+}
+
+func (tf *TrailersFormatter) colorize(text, color string) string {
+	if !tf.useColor {
+		return text
+	}
+	return fmt.Sprintf("%s%s%s", color, text, formatter.ColorReset)
+}
+
+func formatTrailerValue(value models.TrailerValueCount) string {
+	if value.Email == "" {
+		return value.Value
+	}
+	return fmt.Sprintf("%s <%s>", value.Value, value.Email)
+}
@@ -1,12 +1,20 @@
 package gitexec
 
 import (
+	"errors"
 	"fmt"
 	"os/exec"
+	"path"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/DinethDilhara/glo/internal/models"
+	"github.com/DinethDilhara/glo/internal/parser"
 )
 
 type GitExecutor struct{}
@@ -15,45 +23,360 @@ func NewGitExecutor() *GitExecutor {
 	return &GitExecutor{}
 }
 
-func (ge *GitExecutor) GetGitLogs(author, since, until string, maxCount int) ([]models.Commit, error) {
-	args := []string{"log", "--pretty=format:%H|%an|%ad|%s", "--date=iso"}
+type LogOptions struct {
+	Revisions []string
+	All       bool
+	LeftRight bool
+	Author    string
+	Since     string
+	Until     string
+	MaxCount  int
+	Args      []string
+	Paths     []string
+	Follow    bool
+	WithFiles bool
 	
-	if author != "" {
-		args = append(args, "--author="+author)
+	// Merges and NoMerges keep only merge or only non-merge commits.
+	// FirstParent follows only the first parent of merges, the history of
+	// the branch they were merged into.
+	Merges      bool
+	NoMerges    bool
+	FirstParent bool
+	
+	// Signatures verifies commit signatures. Without it Signature is nil
+	// for every commit.
+	Signatures bool
+}
+
+func (ge *GitExecutor) GetGitLogs(opts LogOptions) ([]models.Commit, error) {
+	format := parser.LogFormat
+	if opts.Signatures {
+		format = parser.SignedLogFormat
+	}
+	args := []string{"log", "--use-mailmap", "--pretty=" + format}
+	
+	if opts.Author != "" {
+		args = append(args, "--author="+opts.Author)
+	}
+	if opts.Since != "" {
+		args = append(args, "--since="+opts.Since)
+	}
+	if opts.Until != "" {
+		args = append(args, "--until="+opts.Until)
 	}
-	if since != "" {
-		args = append(args, "--since="+since)
+	if opts.MaxCount > 0 {
+		args = append(args, "--max-count="+strconv.Itoa(opts.MaxCount))
 	}
-	if until != "" {
-		args = append(args, "--until="+until)
+	if opts.WithFiles {
+		args = append(args, "--name-only")
 	}
-	if maxCount > 0 {
-		args = append(args, "--max-count="+strconv.Itoa(maxCount))
+	if opts.Follow {
+		args = append(args, "--follow")
 	}
+	if opts.All {
+		args = append(args, "--all")
+	}
+	if opts.LeftRight {
+		args = append(args, "--left-right")
+	}
+	if opts.Merges {
+		args = append(args, "--merges")
+	}
+	if opts.NoMerges {
+		args = append(args, "--no-merges")
+	}
+	if opts.FirstParent {
+		args = append(args, "--first-parent")
+	}
+	args = append(args, opts.Args...)
+	args = append(args, opts.Revisions...)
+	args = append(args, "--")
+	args = append(args, opts.Paths...)
 	
-	out, err := exec.Command("git", args...).Output()
+	out, err := runGit(args...)
 	if err != nil {
 		return nil, err
 	}
 	
-	var commits []models.Commit
-	lines := strings.Split(string(out), "\n")
-	for _, line := range lines {
-		if strings.TrimSpace(line) == "" {
+	commits, err := parser.NewParser().ParseGitLogOutput(string(out))
+	if err != nil {
+		return nil, err
+	}
+	
+	if !opts.LeftRight {
+		for i := range commits {
+			commits[i].Side = ""
+		}
+	}
+	
+	if err := mapTrailerIdentities(commits); err != nil {
+		return nil, err
+	}
+	
+	return commits, nil
+}
+
+// mapTrailerIdentities applies .mailmap to the identities in trailers such
+// as Co-authored-by, which git log only does for authors and committers.
+func mapTrailerIdentities(commits []models.Commit) error {
+	var contacts []string
+	seen := make(map[string]bool)
+	for _, commit := range commits {
+		for _, trailer := range commit.Trailers {
+			contact := formatContact(trailer.Name, trailer.Email)
+			if trailer.IsIdentity() && !seen[contact] {
+				seen[contact] = true
+				contacts = append(contacts, contact)
+			}
+		}
+	}
+	if len(contacts) == 0 {
+		return nil
+	}
+	
+	cmd := exec.Command("git", "check-mailmap", "--stdin")
+	cmd.Stdin = strings.NewReader(strings.Join(contacts, "\n") + "\n")
+	out, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+	
+	mapped := make(map[string]string, len(contacts))
+	for i, line := range strings.Split(strings.TrimRight(string(out), "\n"), "\n") {
+		if i < len(contacts) {
+			mapped[contacts[i]] = line
+		}
+	}
+	
+	for i := range commits {
+		for j, trailer := range commits[i].Trailers {
+			if !trailer.IsIdentity() {
+				continue
+			}
+			if name, email := parser.ParseIdentity(mapped[formatContact(trailer.Name, trailer.Email)]); email != "" {
+				commits[i].Trailers[j].Name, commits[i].Trailers[j].Email = name, email
+			}
+		}
+		commits[i].CoAuthors = models.CoAuthorsFromTrailers(commits[i].Trailers)
+	}
+	
+	return nil
+}
+
+func formatContact(name, email string) string {
+	if name == "" {
+		return "<" + email + ">"
+	}
+	return name + " <" + email + ">"
+}
+
+// runGit runs git and, when it fails, returns git's own error message rather
+// than just the exit status.
+func runGit(args ...string) ([]byte, error) {
+	return runGitInput("", args...)
+}
+
+// runGitInput is runGit with input on git's standard input, for lists too
+// long to pass as arguments.
+func runGitInput(input string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	if input != "" {
+		cmd.Stdin = strings.NewReader(input)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			message := strings.TrimSpace(string(exitErr.Stderr))
+			message = strings.TrimPrefix(strings.SplitN(message, "\n", 2)[0], "fatal: ")
+			return nil, errors.New(message)
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBlame returns the line ranges of path grouped by the commit that last
+// changed them, as of revision or the working tree when revision is empty.
+func (ge *GitExecutor) GetBlame(path, revision string) (*models.BlameFile, error) {
+	args := []string{"blame", "--porcelain"}
+	if revision != "" {
+		args = append(args, revision)
+	}
+	args = append(args, "--", path)
+	
+	out, err := runGit(args...)
+	if err != nil {
+		return nil, err
+	}
+	
+	ranges, err := parser.NewParser().ParseBlamePorcelain(string(out))
+	if err != nil {
+		return nil, err
+	}
+	
+	blame := &models.BlameFile{Path: path, Revision: revision, Ranges: ranges}
+	for _, r := range ranges {
+		blame.TotalLines += len(r.Lines)
+	}
+	return blame, nil
+}
+
+// ListTextFiles returns the tracked text files under paths, as of revision
+// or the working tree when revision is empty. Binary and empty files are
+// left out.
+func (ge *GitExecutor) ListTextFiles(revision string, paths []string) ([]string, error) {
+	args := []string{"grep", "-I", "-l", "-z", "-e", ""}
+	if revision != "" {
+		args = append(args, revision)
+	}
+	args = append(args, "--")
+	args = append(args, paths...)
+	
+	out, err := runGit(args...)
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	
+	var files []string
+	for _, file := range strings.Split(string(out), "\x00") {
+		if file == "" {
 			continue
 		}
-		
-		parts := strings.SplitN(line, "|", 4)
-		if len(parts) == 4 {
-			commits = append(commits, models.Commit{
-				Hash:    parts[0],
-				Author:  parts[1],
-				Date:    parts[2],
-				Message: parts[3],
-			})
+		if revision != "" {
+			file = strings.TrimPrefix(file, revision+":")
 		}
+		files = append(files, file)
 	}
-	return commits, nil
+	return files, nil
+}
+
+// GetRepositoryRoot returns the top-level directory of the working tree.
+func (ge *GitExecutor) GetRepositoryRoot() (string, error) {
+	out, err := runGit("rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// GetPathPrefix returns the current directory relative to the repository
+// root, with a trailing slash, or "" at the root.
+func (ge *GitExecutor) GetPathPrefix() (string, error) {
+	out, err := runGit("rev-parse", "--show-prefix")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// MatchPathspec reports whether file is selected by a plain pathspec, both
+// relative to the repository root: the path itself, anything below it when
+// it names a directory, or a glob match. As in git, and unlike path.Match,
+// * and ? also match "/", so *.go selects Go files in every directory.
+func MatchPathspec(pathspec, file string) bool {
+	pathspec = strings.TrimPrefix(pathspec, "./")
+	if pathspec == "" || pathspec == "." {
+		return true
+	}
+	
+	dir := strings.TrimSuffix(pathspec, "/")
+	if file == dir || strings.HasPrefix(file, dir+"/") {
+		return true
+	}
+	
+	if !strings.ContainsAny(pathspec, "*?[") {
+		return false
+	}
+	re := globRegexp(pathspec)
+	return re != nil && re.MatchString(file)
+}
+
+// ResolvePathspec turns a pathspec given relative to the current directory,
+// as git reads them, into one relative to the repository root. prefix is
+// the current directory as returned by GetPathPrefix. Pathspecs starting
+// with ":/" are already relative to the root.
+func ResolvePathspec(pathspec, prefix string) string {
+	if rest, ok := strings.CutPrefix(pathspec, ":/"); ok {
+		return rest
+	}
+	if prefix == "" {
+		return pathspec
+	}
+	return path.Join(prefix, pathspec)
+}
+
+var globCache sync.Map
+
+// globRegexp compiles a git wildcard pattern, in which * and ? match any
+// character including "/". It returns nil for a malformed pattern.
+func globRegexp(pattern string) *regexp.Regexp {
+	if cached, ok := globCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp)
+	}
+	
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil
+	}
+	globCache.Store(pattern, re)
+	return re
+}
+
+// GetReachableCommits returns the hashes of all commits reachable from ref.
+func (ge *GitExecutor) GetReachableCommits(ref string) (map[string]bool, error) {
+	out, err := exec.Command("git", "rev-list", ref, "--").Output()
+	if err != nil {
+		return nil, fmt.Errorf("unknown revision '%s'", ref)
+	}
+	
+	hashes := make(map[string]bool)
+	for _, hash := range strings.Fields(string(out)) {
+		hashes[hash] = true
+	}
+	return hashes, nil
+}
+
+func parseGitDate(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
 }
 
 func (ge *GitExecutor) GetCommitCount() (int, error) {
@@ -81,22 +404,83 @@ func (ge *GitExecutor) IsGitRepository() bool {
 	return err == nil
 }
 
-func (ge *GitExecutor) GetBranches(all, remoteOnly bool) ([]models.Branch, error) {
-	args := []string{"branch"}
+// BranchSorts are the keys BranchOptions.Sort accepts. ahead and behind
+// order by the counts against the default branch, largest first.
+var BranchSorts = []string{"name", "date", "author", "ahead", "behind"}
+
+// branchSortKeys are the sorts for-each-ref does itself. author is sorted
+// after .mailmap is applied, and ahead and behind after counting.
+var branchSortKeys = map[string]string{
+	"name": "refname",
+	"date": "-authordate",
+}
+
+type BranchOptions struct {
+	All        bool
+	RemoteOnly bool
+	// Sort is one of BranchSorts; empty keeps git's order.
+	Sort string
+	// Patterns are globs matched against branch names, with or without
+	// the remote; a branch is listed when any of them matches.
+	Patterns []string
+	Merged   string
+	NoMerged string
+	Contains string
+	// Author is a case-insensitive regular expression matched against the
+	// author of each branch's last commit.
+	Author string
+	// CountAgainstDefault fills in how far each branch is ahead of and
+	// behind the default branch, at the cost of one rev-list per branch.
+	// Sorting by ahead or behind needs it.
+	CountAgainstDefault bool
+}
+
+func (opts BranchOptions) filtered() bool {
+	return len(opts.Patterns) > 0 || opts.Merged != "" || opts.NoMerged != "" || opts.Contains != "" || opts.Author != ""
+}
+
+func (ge *GitExecutor) GetBranches(opts BranchOptions) ([]models.Branch, error) {
+	args := []string{"for-each-ref", "--format=%(refname)|%(HEAD)|%(objectname:short)|%(authordate:iso-strict)|%(authorname)|%(upstream:short)|%(upstream:track)|%(contents:subject)"}
 	
-	if all {
-		args = append(args, "-a")
-	} else if remoteOnly {
-		args = append(args, "-r")
+	if opts.Sort != "" {
+		if key, ok := branchSortKeys[opts.Sort]; ok {
+			args = append(args, "--sort="+key)
+		} else if opts.Sort != "author" && opts.Sort != "ahead" && opts.Sort != "behind" {
+			return nil, fmt.Errorf("unknown sort key '%s'. Use: %s", opts.Sort, strings.Join(BranchSorts, ", "))
+		}
+	}
+	if opts.Merged != "" {
+		args = append(args, "--merged="+opts.Merged)
+	}
+	if opts.NoMerged != "" {
+		args = append(args, "--no-merged="+opts.NoMerged)
+	}
+	if opts.Contains != "" {
+		args = append(args, "--contains="+opts.Contains)
 	}
 	
-	args = append(args, "-v", "--format=%(refname:short)|%(HEAD)|%(objectname:short)|%(authordate:short)|%(authorname)|%(contents:subject)")
+	var authorPattern *regexp.Regexp
+	if opts.Author != "" {
+		var err error
+		if authorPattern, err = regexp.Compile("(?i)" + opts.Author); err != nil {
+			return nil, fmt.Errorf("invalid author pattern: %v", err)
+		}
+	}
 	
-	out, err := exec.Command("git", args...).Output()
+	if opts.All {
+		args = append(args, "refs/heads", "refs/remotes")
+	} else if opts.RemoteOnly {
+		args = append(args, "refs/remotes")
+	} else {
+		args = append(args, "refs/heads")
+	}
+	
+	out, err := runGit(args...)
 	if err != nil {
 		return nil, err
 	}
 	
+	remotes := ge.GetRemoteNames()
 	var branches []models.Branch
 	lines := strings.Split(string(out), "\n")
 	
@@ -106,35 +490,668 @@ func (ge *GitExecutor) GetBranches(all, remoteOnly bool) ([]models.Branch, error
 			continue
 		}
 		
-		parts := strings.SplitN(line, "|", 6)
+		parts := strings.SplitN(line, "|", 8)
 		if len(parts) >= 4 {
 			branch := models.Branch{
-				Name:             parts[0],
 				IsCurrent:        parts[1] == "*",
-				IsRemote:         strings.Contains(parts[0], "origin/") || strings.Contains(parts[0], "remote/"),
 				LastCommitHash:   parts[2],
-				LastCommitDate:   parts[3],
+				LastCommitDate:   parseGitDate(parts[3]),
 			}
+			setBranchRef(&branch, parts[0], remotes)
 			
 			if len(parts) >= 5 {
 				branch.LastCommitAuthor = parts[4]
 			}
-			if len(parts) >= 6 {
-				branch.LastCommitMessage = parts[5]
+			if len(parts) >= 7 && parts[5] != "" {
+				upstream := models.Upstream{Name: parts[5]}
+				upstream.Ahead, upstream.Behind, upstream.Gone = parseTrack(parts[6])
+				branch.Upstream = &upstream
+			}
+			if len(parts) >= 8 {
+				branch.LastCommitMessage = parts[7]
+			}
+			
+			if len(opts.Patterns) > 0 && !matchBranchPatterns(opts.Patterns, branch) {
+				continue
 			}
 			
 			branches = append(branches, branch)
 		}
 	}
 	
-	if len(branches) == 0 {
-		return ge.getBranchesBasic(all, remoteOnly)
+	if err := setBranchAuthors(branches); err != nil {
+		return nil, err
+	}
+	if authorPattern != nil {
+		matched := branches[:0]
+		for _, branch := range branches {
+			if authorPattern.MatchString(branch.LastCommitAuthor) {
+				matched = append(matched, branch)
+			}
+		}
+		branches = matched
+	}
+	
+	if len(branches) == 0 && !opts.filtered() {
+		return ge.getBranchesBasic(opts.All, opts.RemoteOnly, opts.CountAgainstDefault)
+	}
+	
+	if opts.CountAgainstDefault {
+		ge.countAgainstDefault(branches)
+	}
+	
+	switch opts.Sort {
+	case "author":
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branches[i].LastCommitAuthor < branches[j].LastCommitAuthor
+		})
+	case "ahead":
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branches[i].AheadDefault > branches[j].AheadDefault
+		})
+	case "behind":
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branches[i].BehindDefault > branches[j].BehindDefault
+		})
 	}
 	
 	return branches, nil
 }
 
-func (ge *GitExecutor) getBranchesBasic(all, remoteOnly bool) ([]models.Branch, error) {
+// setBranchAuthors replaces the author for-each-ref gives each branch with
+// the .mailmap one, as git log and getLastCommitForBranch show it; before
+// git 2.41 for-each-ref has no %(authorname:mailmap).
+func setBranchAuthors(branches []models.Branch) error {
+	if len(branches) == 0 {
+		return nil
+	}
+	
+	var hashes strings.Builder
+	for _, branch := range branches {
+		hashes.WriteString(branch.LastCommitHash + "\n")
+	}
+	
+	cmd := exec.Command("git", "log", "--no-walk", "--stdin", "--use-mailmap", "--format=%h%x1f%aN")
+	cmd.Stdin = strings.NewReader(hashes.String())
+	out, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+	
+	authors := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimRight(string(out), "\n"), "\n") {
+		if hash, name, ok := strings.Cut(line, "\x1f"); ok {
+			authors[hash] = name
+		}
+	}
+	for i := range branches {
+		if name, ok := authors[branches[i].LastCommitHash]; ok {
+			branches[i].LastCommitAuthor = name
+		}
+	}
+	
+	return nil
+}
+
+// matchBranchPatterns reports whether any of the glob patterns selects the
+// branch. As with pathspecs a pattern also selects the branches below it,
+// so feature and feature/* both match feature/auth/login. Remote-tracking
+// branches match with or without their remote, e.g. origin/main and main.
+func matchBranchPatterns(patterns []string, branch models.Branch) bool {
+	names := []string{branch.Name}
+	if branch.Remote != "" {
+		names = append(names, strings.TrimPrefix(branch.Name, branch.Remote+"/"))
+	}
+	
+	for _, pattern := range patterns {
+		dir, deep := strings.CutSuffix(pattern, "/*")
+		for _, name := range names {
+			if matchBranchPattern(pattern, name) || (deep && name != dir && matchBranchPattern(dir, name)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchBranchPattern matches a branch name like a pathspec, except that
+// wildcards stay within one "/"-separated level as in path.Match.
+func matchBranchPattern(pattern, name string) bool {
+	dir := strings.TrimSuffix(pattern, "/")
+	if name == dir || strings.HasPrefix(name, dir+"/") {
+		return true
+	}
+	matched, err := path.Match(pattern, name)
+	return err == nil && matched
+}
+
+// setBranchRef names a branch after its full refname: refs/heads/<name> is
+// a local branch and refs/remotes/<remote>/<name> a remote-tracking branch
+// of <remote>. Anything else, such as a detached HEAD, keeps its name.
+func setBranchRef(branch *models.Branch, ref string, remotes []string) {
+	branch.RefName = ref
+	branch.Name = ref
+	
+	switch {
+	case strings.HasPrefix(ref, "refs/heads/"):
+		branch.Name = strings.TrimPrefix(ref, "refs/heads/")
+	case strings.HasPrefix(ref, "refs/remotes/"):
+		branch.Name = strings.TrimPrefix(ref, "refs/remotes/")
+		branch.IsRemote = true
+		branch.Remote = remoteOf(branch.Name, remotes)
+	}
+}
+
+// remoteOf returns the remote of a remote-tracking branch name such as
+// "upstream/feature/x". Remote names may contain slashes, so the longest
+// configured remote that prefixes the name wins; refs of remotes that are
+// no longer configured fall back to the first path component.
+func remoteOf(name string, remotes []string) string {
+	remote := ""
+	for _, candidate := range remotes {
+		if strings.HasPrefix(name, candidate+"/") && len(candidate) > len(remote) {
+			remote = candidate
+		}
+	}
+	if remote == "" {
+		remote, _, _ = strings.Cut(name, "/")
+	}
+	return remote
+}
+
+// GetRemoteNames returns the names of the configured remotes, or nil when
+// there are none or they cannot be read.
+func (ge *GitExecutor) GetRemoteNames() []string {
+	out, err := runGit("remote")
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(string(out))
+}
+
+// GetRemotes returns the configured remotes with their URLs, the branch
+// their HEAD points to and how many remote-tracking and local tracking
+// branches each has.
+func (ge *GitExecutor) GetRemotes() ([]models.Remote, error) {
+	out, err := runGit("remote", "-v")
+	if err != nil {
+		return nil, err
+	}
+	
+	var remotes []models.Remote
+	index := make(map[string]int)
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		
+		i, ok := index[fields[0]]
+		if !ok {
+			i = len(remotes)
+			index[fields[0]] = i
+			remotes = append(remotes, models.Remote{Name: fields[0]})
+		}
+		switch fields[2] {
+		case "(fetch)":
+			remotes[i].FetchURL = fields[1]
+		case "(push)":
+			remotes[i].PushURL = fields[1]
+		}
+	}
+	
+	names := make([]string, len(remotes))
+	for i, remote := range remotes {
+		names[i] = remote.Name
+	}
+	
+	out, err = runGit("for-each-ref", "--format=%(refname)%00%(symref)", "refs/remotes")
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		ref, symref, found := strings.Cut(line, "\x00")
+		if !found {
+			continue
+		}
+		
+		name := strings.TrimPrefix(ref, "refs/remotes/")
+		i, ok := index[remoteOf(name, names)]
+		if !ok {
+			continue
+		}
+		if symref != "" {
+			remotes[i].HeadBranch = strings.TrimPrefix(symref, "refs/remotes/"+remotes[i].Name+"/")
+			continue
+		}
+		remotes[i].Branches++
+	}
+	
+	out, err = runGit("for-each-ref", "--format=%(upstream:remotename)", "refs/heads")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range strings.Split(string(out), "\n") {
+		if i, ok := index[name]; ok {
+			remotes[i].Tracking++
+		}
+	}
+	
+	return remotes, nil
+}
+
+// countAgainstDefault fills in how far each branch is ahead of and behind
+// the default branch. Branches that cannot be compared, such as symbolic
+// refs like origin/HEAD, are left at zero.
+func (ge *GitExecutor) countAgainstDefault(branches []models.Branch) {
+	base, err := ge.GetDefaultBranch()
+	if err != nil || base == "" {
+		return
+	}
+	
+	for i := range branches {
+		if branches[i].RefName == "refs/heads/"+base || strings.HasSuffix(branches[i].Name, "/HEAD") {
+			continue
+		}
+		// Full refnames, so that a local branch named origin/x is not
+		// confused with the remote-tracking branch.
+		ahead, behind, err := ge.GetAheadBehind("refs/heads/"+base, branches[i].RefName)
+		if err != nil {
+			continue
+		}
+		branches[i].DefaultBranch = base
+		branches[i].AheadDefault = ahead
+		branches[i].BehindDefault = behind
+	}
+}
+
+// parseTrack parses %(upstream:track), e.g. "[ahead 1, behind 2]" or "[gone]".
+func parseTrack(track string) (ahead, behind int, gone bool) {
+	track = strings.Trim(track, "[]")
+	if track == "gone" {
+		return 0, 0, true
+	}
+	for _, part := range strings.Split(track, ",") {
+		fields := strings.Fields(part)
+		if len(fields) != 2 {
+			continue
+		}
+		count, _ := strconv.Atoi(fields[1])
+		switch fields[0] {
+		case "ahead":
+			ahead = count
+		case "behind":
+			behind = count
+		}
+	}
+	return ahead, behind, false
+}
+
+// GetDefaultBranch returns the branch other branches are measured against:
+// the local counterpart of origin/HEAD when it exists, otherwise the first
+// of main, master, trunk and develop present locally, otherwise the current
+// branch.
+func (ge *GitExecutor) GetDefaultBranch() (string, error) {
+	if out, err := runGit("symbolic-ref", "--short", "refs/remotes/origin/HEAD"); err == nil {
+		name := strings.TrimPrefix(strings.TrimSpace(string(out)), "origin/")
+		if ge.refExists("refs/heads/" + name) {
+			return name, nil
+		}
+	}
+	
+	for _, name := range []string{"main", "master", "trunk", "develop"} {
+		if ge.refExists("refs/heads/" + name) {
+			return name, nil
+		}
+	}
+	
+	return ge.GetCurrentBranch()
+}
+
+func (ge *GitExecutor) refExists(ref string) bool {
+	return exec.Command("git", "show-ref", "--verify", "--quiet", ref).Run() == nil
+}
+
+// GetAheadBehind counts the commits ref has that base does not (ahead) and
+// the commits base has that ref does not (behind).
+func (ge *GitExecutor) GetAheadBehind(base, ref string) (int, int, error) {
+	out, err := runGit("rev-list", "--left-right", "--count", base+"..."+ref, "--")
+	if err != nil {
+		return 0, 0, err
+	}
+	
+	counts := strings.Fields(string(out))
+	if len(counts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", string(out))
+	}
+	behind, _ := strconv.Atoi(counts[0])
+	ahead, _ := strconv.Atoi(counts[1])
+	return ahead, behind, nil
+}
+
+// GetMergeBase returns the full hash of the best common ancestor of a and
+// b, or "" when their histories are unrelated.
+func (ge *GitExecutor) GetMergeBase(a, b string) (string, error) {
+	out, err := runGit("merge-base", a, b)
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// GetMergedCommits returns the commits a merge brought in: those reachable
+// from its other parents but not from its first parent, newest first.
+func (ge *GitExecutor) GetMergedCommits(merge models.Commit) ([]models.Commit, error) {
+	if !merge.IsMerge() {
+		return nil, nil
+	}
+
+	revisions := []string{"^" + merge.Parents[0]}
+	revisions = append(revisions, merge.Parents[1:]...)
+	return ge.GetGitLogs(LogOptions{Revisions: revisions})
+}
+
+// GetDiffStat returns the files changed on to since its merge base with
+// from, as git diff from...to shows them, with renames detected. Status is
+// git's status letter: A, M, D, R, C or T.
+func (ge *GitExecutor) GetDiffStat(from, to string) ([]models.FileChange, error) {
+	diffRange := from + "..." + to
+	
+	out, err := runGit("diff", "--name-status", "-z", "-M", diffRange, "--")
+	if err != nil {
+		return nil, err
+	}
+	
+	var changes []models.FileChange
+	index := make(map[string]int)
+	fields := strings.Split(string(out), "\x00")
+	for i := 0; i < len(fields); i++ {
+		if fields[i] == "" {
+			continue
+		}
+		
+		change := models.FileChange{Status: fields[i][:1]}
+		switch {
+		case (change.Status == "R" || change.Status == "C") && i+2 < len(fields):
+			change.OldPath, change.Path = fields[i+1], fields[i+2]
+			i += 2
+		case i+1 < len(fields):
+			change.Path = fields[i+1]
+			i++
+		}
+		
+		index[change.Path] = len(changes)
+		changes = append(changes, change)
+	}
+	
+	out, err = runGit("diff", "--numstat", "-z", "-M", diffRange, "--")
+	if err != nil {
+		return nil, err
+	}
+	
+	// Renames are "added\tdeleted\t" followed by the old and new paths as
+	// separate fields.
+	fields = strings.Split(string(out), "\x00")
+	for i := 0; i < len(fields); i++ {
+		stat := strings.SplitN(fields[i], "\t", 3)
+		if len(stat) != 3 {
+			continue
+		}
+		
+		path := stat[2]
+		if path == "" && i+2 < len(fields) {
+			path = fields[i+2]
+			i += 2
+		}
+		
+		j, ok := index[path]
+		if !ok {
+			continue
+		}
+		if stat[0] == "-" {
+			changes[j].Binary = true
+			continue
+		}
+		changes[j].Additions, _ = strconv.Atoi(stat[0])
+		changes[j].Deletions, _ = strconv.Atoi(stat[1])
+	}
+	
+	return changes, nil
+}
+
+// TrialMerge merges branch into target in memory with git merge-tree
+// (git 2.38 or newer), leaving the working tree, index and refs alone, and
+// reports the files that would conflict along with their conflict hunks.
+func (ge *GitExecutor) TrialMerge(target, branch string) (*models.MergePrediction, error) {
+	out, err := exec.Command("git", "merge-tree", "--write-tree", "-z", "--name-only", target, branch).Output()
+	
+	// Exit status 1 with a tree on stdout means the merge has conflicts;
+	// without one git could not merge at all, e.g. for an unknown branch.
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 && len(out) > 0 {
+		err = nil
+	}
+	if err != nil {
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			message := strings.TrimSpace(string(exitErr.Stderr))
+			if strings.Contains(message, "usage:") {
+				return nil, fmt.Errorf("git merge-tree --write-tree requires git 2.38 or newer")
+			}
+			return nil, errors.New(strings.TrimPrefix(strings.SplitN(message, "\n", 2)[0], "fatal: "))
+		}
+		return nil, err
+	}
+	
+	p := parser.NewParser()
+	tree, files, err := p.ParseMergeTree(string(out))
+	if err != nil {
+		return nil, err
+	}
+	
+	for i := range files {
+		content, err := exec.Command("git", "cat-file", "blob", tree+":"+files[i].Path).Output()
+		if err != nil {
+			// Deleted on one side, or not a regular file: no markers.
+			continue
+		}
+		files[i].Hunks = p.ParseConflictHunks(string(content))
+	}
+	
+	return &models.MergePrediction{
+		Branch: branch,
+		Target: target,
+		Clean:  len(files) == 0,
+		Files:  files,
+	}, nil
+}
+
+// DeleteBranch force-deletes a local branch and returns the full hash of the
+// commit it pointed to.
+func (ge *GitExecutor) DeleteBranch(name string) (string, error) {
+	out, err := runGit("rev-parse", "--verify", "refs/heads/"+name)
+	if err != nil {
+		return "", err
+	}
+	hash := strings.TrimSpace(string(out))
+	
+	if _, err := runGit("branch", "-D", name); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// CreateBranch creates a local branch at hash. It fails if the branch
+// already exists.
+func (ge *GitExecutor) CreateBranch(name, hash string) error {
+	_, err := runGit("branch", name, hash)
+	return err
+}
+
+// GetGitDir returns the absolute path of the git directory shared by all
+// worktrees of the repository.
+func (ge *GitExecutor) GetGitDir() (string, error) {
+	out, err := runGit("rev-parse", "--path-format=absolute", "--git-common-dir")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// GetHooksDir returns the directory git runs hooks from, honoring
+// core.hooksPath.
+func (ge *GitExecutor) GetHooksDir() (string, error) {
+	out, err := runGit("rev-parse", "--path-format=absolute", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// GetReflog reads the reflog of ref, HEAD or anything that names a ref
+// such as "main" or "origin/main". It walks the reflog itself, so entries
+// whose commits are no longer reachable are kept.
+func (ge *GitExecutor) GetReflog(ref string) (*models.Reflog, error) {
+	fullName := ref
+	if ref != "HEAD" {
+		out, err := runGit("rev-parse", "--symbolic-full-name", ref)
+		if err != nil {
+			return nil, err
+		}
+		fullName = strings.TrimSpace(string(out))
+		if !strings.HasPrefix(fullName, "refs/") {
+			return nil, fmt.Errorf("'%s' is not a ref", ref)
+		}
+	}
+	
+	out, err := runGit("log", "-g", "--date=raw", "--format="+parser.ReflogFormat, fullName, "--")
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%s has no reflog", ref)
+	}
+	
+	entries, err := parser.NewParser().ParseReflog(ref, string(out))
+	if err != nil {
+		return nil, err
+	}
+	return &models.Reflog{Ref: ref, Entries: entries}, nil
+}
+
+// GetTags lists the tags matching patterns, all tags without patterns,
+// newest first. Signed tags are verified with git verify-tag.
+func (ge *GitExecutor) GetTags(patterns []string) ([]models.Tag, error) {
+	args := []string{"for-each-ref", "--sort=-creatordate",
+		"--format=%(refname)%1f%(objecttype)%1f%(objectname)%1f%(*objectname)%1f%(taggername)%1f%(creatordate:iso-strict)%1f%(contents:subject)%1f%(if)%(contents:signature)%(then)signed%(end)%1e"}
+	if len(patterns) == 0 {
+		args = append(args, "refs/tags")
+	}
+	for _, pattern := range patterns {
+		args = append(args, "refs/tags/"+pattern)
+	}
+	
+	out, err := runGit(args...)
+	if err != nil {
+		return nil, err
+	}
+	
+	var tags []models.Tag
+	for _, record := range strings.Split(string(out), "\x1e") {
+		fields := strings.Split(strings.TrimPrefix(record, "\n"), "\x1f")
+		if len(fields) != 8 {
+			continue
+		}
+		
+		tag := models.Tag{
+			Name:   strings.TrimPrefix(fields[0], "refs/tags/"),
+			Commit: fields[2],
+		}
+		tag.Date, _ = time.Parse(time.RFC3339, fields[5])
+		if fields[1] == "tag" {
+			tag.Annotated = true
+			tag.Commit = fields[3]
+			tag.Tagger = fields[4]
+			tag.Subject = fields[6]
+		}
+		if fields[7] != "" {
+			output, err := exec.Command("git", "verify-tag", "--raw", fields[0]).CombinedOutput()
+			tag.Signature = parser.ParseTagVerification(string(output), err == nil)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// CommitExists reports whether hash names a commit that is still in the
+// object database, i.e. has not been garbage collected.
+func (ge *GitExecutor) CommitExists(hash string) bool {
+	return exec.Command("git", "cat-file", "-e", hash+"^{commit}").Run() == nil
+}
+
+// BranchExists reports whether a local branch of that name exists.
+func (ge *GitExecutor) BranchExists(name string) bool {
+	return ge.refExists("refs/heads/" + name)
+}
+
+// GetRawMessages returns the full messages of the given commits, exactly as
+// recorded, by hash. Unlike %s and %b they keep a subject that runs over
+// several lines intact.
+func (ge *GitExecutor) GetRawMessages(hashes []string) (map[string]string, error) {
+	messages := make(map[string]string, len(hashes))
+	if len(hashes) == 0 {
+		return messages, nil
+	}
+	
+	out, err := runGitInput(strings.Join(hashes, "\n")+"\n", "log", "--no-walk=unsorted", "--stdin", "--format=%x1e%H%x1f%B")
+	if err != nil {
+		return nil, err
+	}
+	
+	for _, record := range strings.Split(string(out), "\x1e") {
+		hash, message, found := strings.Cut(record, "\x1f")
+		if found {
+			messages[hash] = strings.TrimRight(message, "\n")
+		}
+	}
+	return messages, nil
+}
+
+// CleanMessage strips what git strips from a message being committed:
+// everything below the scissors line of --verbose, comment lines, trailing
+// whitespace and surrounding blank lines. Comments are recognized by
+// core.commentChar, as git stripspace does.
+func (ge *GitExecutor) CleanMessage(text string) (string, error) {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		// The scissors line starts with the comment character, whatever it is.
+		if _, size := utf8.DecodeRuneInString(line); line[size:] == " ------------------------ >8 ------------------------" {
+			lines = lines[:i]
+			break
+		}
+	}
+	
+	out, err := runGitInput(strings.Join(lines, "\n")+"\n", "stripspace", "--strip-comments")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(out), "\n"), nil
+}
+
+// GetFileTrailers parses the trailers of the commit message in path the way
+// git interpret-trailers finds them.
+func (ge *GitExecutor) GetFileTrailers(path string) ([]models.Trailer, error) {
+	out, err := runGit("interpret-trailers", "--parse", path)
+	if err != nil {
+		return nil, err
+	}
+	return parser.ParseTrailers(string(out)), nil
+}
+
+func (ge *GitExecutor) getBranchesBasic(all, remoteOnly, count bool) ([]models.Branch, error) {
 	args := []string{"branch"}
 	
 	if all {
@@ -148,6 +1165,7 @@ func (ge *GitExecutor) getBranchesBasic(all, remoteOnly bool) ([]models.Branch,
 		return nil, err
 	}
 	
+	remotes := ge.GetRemoteNames()
 	var branches []models.Branch
 	lines := strings.Split(string(out), "\n")
 	
@@ -162,15 +1180,27 @@ func (ge *GitExecutor) getBranchesBasic(all, remoteOnly bool) ([]models.Branch,
 			line = strings.TrimSpace(line[1:])
 		}
 		
-		line = strings.TrimSpace(line)
+		line, _, _ = strings.Cut(strings.TrimSpace(line), " -> ")
+		
+		// git branch -a prefixes remote-tracking branches with remotes/,
+		// git branch -r does not.
+		ref := "refs/heads/" + line
+		if strings.HasPrefix(line, "(") {
+			ref = line
+		} else if all {
+			if strings.HasPrefix(line, "remotes/") {
+				ref = "refs/" + line
+			}
+		} else if remoteOnly {
+			ref = "refs/remotes/" + line
+		}
 		
 		branch := models.Branch{
-			Name:      line,
 			IsCurrent: isCurrent,
-			IsRemote:  strings.Contains(line, "origin/") || strings.Contains(line, "remotes/"),
 		}
+		setBranchRef(&branch, ref, remotes)
 		
-		if commitInfo, err := ge.getLastCommitForBranch(line); err == nil {
+		if commitInfo, err := ge.getLastCommitForBranch(branch.RefName); err == nil {
 			branch.LastCommitHash = commitInfo.Hash
 			branch.LastCommitMessage = commitInfo.Message
 			branch.LastCommitAuthor = commitInfo.Author
@@ -180,11 +1210,14 @@ func (ge *GitExecutor) getBranchesBasic(all, remoteOnly bool) ([]models.Branch,
 		branches = append(branches, branch)
 	}
 	
+	if count {
+		ge.countAgainstDefault(branches)
+	}
 	return branches, nil
 }
 
 func (ge *GitExecutor) getLastCommitForBranch(branchName string) (*models.Commit, error) {
-	out, err := exec.Command("git", "log", "-1", "--pretty=format:%H|%an|%ad|%s", "--date=short", branchName).Output()
+	out, err := exec.Command("git", "log", "-1", "--pretty=format:%H|%aN|%aI|%s", branchName).Output()
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +1228,7 @@ func (ge *GitExecutor) getLastCommitForBranch(branchName string) (*models.Commit
 		return &models.Commit{
 			Hash:    parts[0][:8], 
 			Author:  parts[1],
-			Date:    parts[2],
+			Date:    parseGitDate(parts[2]),
 			Message: parts[3],
 		}, nil
 	}
@@ -204,7 +1237,7 @@ func (ge *GitExecutor) getLastCommitForBranch(branchName string) (*models.Commit
 }
 
 func (ge *GitExecutor) GetCommitGraph(limit int) ([]models.Commit, error) {
-	args := []string{"log", "--graph", "--oneline", "--decorate", "--all", "--pretty=format:%H|%an|%ad|%s", "--date=short"}
+	args := []string{"log", "--graph", "--oneline", "--decorate", "--all", "--pretty=format:%H|%P|%aN|%aI|%s"}
 	
 	if limit > 0 {
 		args = append(args, "--max-count="+strconv.Itoa(limit))
@@ -225,14 +1258,15 @@ func (ge *GitExecutor) GetCommitGraph(limit int) ([]models.Commit, error) {
 		}
 		
 		cleanLine := strings.TrimLeft(line, "* |\\/_")
-		parts := strings.SplitN(cleanLine, "|", 4)
+		parts := strings.SplitN(cleanLine, "|", 5)
 		
-		if len(parts) == 4 {
+		if len(parts) == 5 {
 			commits = append(commits, models.Commit{
 				Hash:    parts[0],
-				Author:  parts[1],
-				Date:    parts[2],
-				Message: parts[3],
+				Parents: strings.Fields(parts[1]),
+				Author:  parts[2],
+				Date:    parseGitDate(parts[3]),
+				Message: parts[4],
 			})
 		}
 	}
@@ -0,0 +1,58 @@
+package gitexec
+
+import "testing"
+
+func TestMatchPathspec(t *testing.T) {
+	tests := []struct {
+		pathspec string
+		file     string
+		want     bool
+	}{
+		{"cmd/log.go", "cmd/log.go", true},
+		{"cmd", "cmd/log.go", true},
+		{"cmd/", "cmd/log.go", true},
+		{"./cmd", "cmd/log.go", true},
+		{".", "cmd/log.go", true},
+		{"cm", "cmd/log.go", false},
+		{"cmd/log", "cmd/log.go", false},
+		{"*.go", "main.go", true},
+		{"*.go", "cmd/log.go", true},
+		{"*.go", "internal/query/query.go", true},
+		{"cmd/*.go", "cmd/sub/x.go", true},
+		{"*.md", "cmd/log.go", false},
+		{"cmd/?og.go", "cmd/log.go", true},
+		{"cmd/[lb]og.go", "cmd/log.go", true},
+		{"cmd/[!l]og.go", "cmd/log.go", false},
+		{"a[1].txt", "a[1].txt", true},
+		{`a\*.txt`, "a*.txt", true},
+		{`a\*.txt`, "ab.txt", false},
+		{"cmd/[oops", "cmd/log.go", false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchPathspec(tt.pathspec, tt.file); got != tt.want {
+			t.Errorf("MatchPathspec(%q, %q) = %v, want %v", tt.pathspec, tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestResolvePathspec(t *testing.T) {
+	tests := []struct {
+		pathspec string
+		prefix   string
+		want     string
+	}{
+		{"log.go", "", "log.go"},
+		{"log.go", "cmd/", "cmd/log.go"},
+		{"*.go", "cmd/", "cmd/*.go"},
+		{"../README.md", "cmd/", "README.md"},
+		{".", "cmd/", "cmd"},
+		{":/README.md", "cmd/", "README.md"},
+	}
+
+	for _, tt := range tests {
+		if got := ResolvePathspec(tt.pathspec, tt.prefix); got != tt.want {
+			t.Errorf("ResolvePathspec(%q, %q) = %q, want %q", tt.pathspec, tt.prefix, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package health
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+var Statuses = []string{models.BranchActive, models.BranchMerged, models.BranchEmpty, models.BranchStale, models.BranchDiverged}
+
+type Options struct {
+	// StaleAfterDays is the age of the last commit after which an unmerged
+	// branch is stale.
+	StaleAfterDays int
+	// DivergedBehind is how many commits a branch with commits of its own
+	// may fall behind the base before it counts as diverged.
+	DivergedBehind int
+	Now            time.Time
+}
+
+func ValidStatus(status string) bool {
+	for _, s := range Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Classify sets the age, status and reasons of a branch whose ahead/behind
+// counts and upstream have been filled in. A branch with nothing ahead of
+// the base is merged whatever its age once the base has moved past it, and
+// empty while it still points at the base tip: it may just have been
+// created. Otherwise it is stale when old, diverged when it has commits of
+// its own and is far behind the base or out of sync with its upstream, and
+// active when none of these apply. A gone upstream is always reported as a
+// reason.
+func Classify(branch *models.BranchHealth, opts Options) {
+	branch.Merged = branch.AheadBase == 0 && branch.BehindBase > 0
+	branch.AgeDays = int(opts.Now.Sub(branch.LastCommitDate).Hours() / 24)
+	branch.Reasons = nil
+
+	if branch.UpstreamGone() {
+		branch.Reasons = append(branch.Reasons, fmt.Sprintf("upstream %s is gone", branch.Upstream.Name))
+	}
+
+	switch {
+	case branch.Merged:
+		branch.Status = models.BranchMerged
+		branch.Reasons = append(branch.Reasons, "fully merged")
+	case branch.AheadBase == 0:
+		branch.Status = models.BranchEmpty
+		branch.Reasons = append(branch.Reasons, "no commits of its own")
+	case branch.AgeDays > opts.StaleAfterDays:
+		branch.Status = models.BranchStale
+		branch.Reasons = append(branch.Reasons, fmt.Sprintf("no commits for %d days", branch.AgeDays))
+	case branch.AheadBase > 0 && branch.BehindBase > opts.DivergedBehind:
+		branch.Status = models.BranchDiverged
+		branch.Reasons = append(branch.Reasons, fmt.Sprintf("%d ahead, %d behind", branch.AheadBase, branch.BehindBase))
+	case branch.Upstream != nil && branch.Upstream.Ahead > 0 && branch.Upstream.Behind > 0:
+		branch.Status = models.BranchDiverged
+		branch.Reasons = append(branch.Reasons, fmt.Sprintf("diverged from %s (%d ahead, %d behind)",
+			branch.Upstream.Name, branch.Upstream.Ahead, branch.Upstream.Behind))
+	default:
+		branch.Status = models.BranchActive
+	}
+}
+
+// Report classifies branches and keeps those whose status is in statuses,
+// or all of them when statuses is empty. Branches are ordered by status and
+// then oldest first.
+func Report(base string, branches []models.BranchHealth, statuses []string, opts Options) *models.BranchHealthReport {
+	report := &models.BranchHealthReport{
+		Base:           base,
+		StaleAfterDays: opts.StaleAfterDays,
+		Counts:         make(map[string]int),
+	}
+
+	for i := range branches {
+		Classify(&branches[i], opts)
+		report.Counts[branches[i].Status]++
+		if len(statuses) == 0 || contains(statuses, branches[i].Status) {
+			report.Branches = append(report.Branches, branches[i])
+		}
+	}
+
+	sort.SliceStable(report.Branches, func(i, j int) bool {
+		a, b := report.Branches[i], report.Branches[j]
+		if a.Status != b.Status {
+			return statusRank(a.Status) < statusRank(b.Status)
+		}
+		return a.LastCommitDate.Before(b.LastCommitDate)
+	})
+
+	return report
+}
+
+func statusRank(status string) int {
+	for i, s := range []string{models.BranchMerged, models.BranchEmpty, models.BranchStale, models.BranchDiverged, models.BranchActive} {
+		if s == status {
+			return i
+		}
+	}
+	return len(Statuses)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package health
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+func TestClassify(t *testing.T) {
+	now := time.Date(2025, time.July, 16, 12, 0, 0, 0, time.UTC)
+	opts := Options{StaleAfterDays: 90, DivergedBehind: 50, Now: now}
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	tests := []struct {
+		name   string
+		branch models.BranchHealth
+		status string
+		merged bool
+	}{
+		{"merged", models.BranchHealth{AheadBase: 0, BehindBase: 3, LastCommitDate: daysAgo(200)}, models.BranchMerged, true},
+		{"at the base tip", models.BranchHealth{AheadBase: 0, BehindBase: 0, LastCommitDate: daysAgo(200)}, models.BranchEmpty, false},
+		{"stale", models.BranchHealth{AheadBase: 2, BehindBase: 100, LastCommitDate: daysAgo(120)}, models.BranchStale, false},
+		{"diverged", models.BranchHealth{AheadBase: 2, BehindBase: 51, LastCommitDate: daysAgo(5)}, models.BranchDiverged, false},
+		{"behind by the threshold", models.BranchHealth{AheadBase: 2, BehindBase: 50, LastCommitDate: daysAgo(5)}, models.BranchActive, false},
+		{"diverged from upstream", models.BranchHealth{AheadBase: 1, LastCommitDate: daysAgo(1),
+			Upstream: &models.Upstream{Name: "origin/x", Ahead: 1, Behind: 2}}, models.BranchDiverged, false},
+		{"active", models.BranchHealth{AheadBase: 4, BehindBase: 2, LastCommitDate: daysAgo(1)}, models.BranchActive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			branch := tt.branch
+			Classify(&branch, opts)
+			if branch.Status != tt.status || branch.Merged != tt.merged {
+				t.Errorf("status = %s, merged = %v; want %s, %v (reasons %q)", branch.Status, branch.Merged, tt.status, tt.merged, branch.Reasons)
+			}
+		})
+	}
+}
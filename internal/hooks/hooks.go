@@ -0,0 +1,179 @@
+package hooks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// Marker is the line that identifies a hook script written by glo.
+const Marker = "# Managed by glo hooks"
+
+// ChainedSuffix is added to the name of a hook glo replaced. The managed
+// script runs it first and stops when it fails.
+const ChainedSuffix = ".glo-chained"
+
+// Definition is a hook glo can install. Command is the shell code run after
+// any chained hook; with Stdin, the hook's input is kept in $input so both
+// can read it.
+type Definition struct {
+	Name        string
+	Description string
+	Command     string
+	Stdin       bool
+}
+
+// Definitions are the hooks glo hooks install manages.
+var Definitions = []Definition{
+	{
+		Name:        "commit-msg",
+		Description: "check each new commit message with glo lint",
+		Command:     `exec glo lint --file "$1"`,
+	},
+	{
+		Name:        "pre-push",
+		Description: "check the messages of pushed commits with glo lint",
+		Stdin:       true,
+		Command: `printf '%s\n' "$input" | while read -r local_ref local_sha remote_ref remote_sha; do
+	case "$local_sha" in *[!0]*) ;; *) continue ;; esac
+	# New branches, and remote tips not fetched yet, are checked against
+	# every known remote branch.
+	range="$local_sha $(git for-each-ref --format='^%(objectname)' refs/remotes)"
+	case "$remote_sha" in
+	*[!0]*) git cat-file -e "$remote_sha^{commit}" 2>/dev/null && range="$remote_sha..$local_sha" ;;
+	esac
+	glo lint $range || exit 1
+done`,
+	},
+}
+
+// Lookup returns the definition of a hook glo manages.
+func Lookup(name string) (Definition, bool) {
+	for _, definition := range Definitions {
+		if definition.Name == name {
+			return definition, true
+		}
+	}
+	return Definition{}, false
+}
+
+// Script returns the hook script for a definition.
+func Script(definition Definition) string {
+	var script strings.Builder
+
+	script.WriteString("#!/bin/sh\n")
+	script.WriteString(fmt.Sprintf("%s: %s.\n", Marker, definition.Description))
+	script.WriteString("# Reinstall with glo hooks install, remove with glo hooks remove.\n\n")
+	if definition.Stdin {
+		script.WriteString("input=$(cat)\n")
+	}
+	script.WriteString(fmt.Sprintf("chained=\"$0%s\"\n", ChainedSuffix))
+	script.WriteString("if [ -x \"$chained\" ]; then\n")
+	if definition.Stdin {
+		script.WriteString("\tprintf '%s\\n' \"$input\" | \"$chained\" \"$@\" || exit $?\n")
+	} else {
+		script.WriteString("\t\"$chained\" \"$@\" || exit $?\n")
+	}
+	script.WriteString("fi\n\n")
+	script.WriteString(definition.Command + "\n")
+
+	return script.String()
+}
+
+// Install writes the managed script of a hook into dir. A hook that glo did
+// not write is kept under ChainedSuffix and run first; chained reports
+// whether that happened now.
+func Install(dir string, definition Definition) (chained bool, err error) {
+	path := filepath.Join(dir, definition.Name)
+
+	if content, err := os.ReadFile(path); err == nil && !isManaged(string(content)) {
+		if _, err := os.Stat(path + ChainedSuffix); err == nil {
+			return false, fmt.Errorf("%s and %s both exist; remove one of them first", path, path+ChainedSuffix)
+		}
+		if err := os.Rename(path, path+ChainedSuffix); err != nil {
+			return false, err
+		}
+		chained = true
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return chained, err
+	}
+	if err := os.WriteFile(path, []byte(Script(definition)), 0o755); err != nil {
+		return chained, err
+	}
+	return chained, os.Chmod(path, 0o755)
+}
+
+// Remove deletes a managed hook and puts back the hook it chained, if any;
+// restored reports whether it did.
+func Remove(dir, name string) (restored bool, err error) {
+	path := filepath.Join(dir, name)
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, fmt.Errorf("no %s hook is installed", name)
+	}
+	if err != nil {
+		return false, err
+	}
+	if !isManaged(string(content)) {
+		return false, fmt.Errorf("the %s hook was not installed by glo", name)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(path + ChainedSuffix); err == nil {
+		return true, os.Rename(path+ChainedSuffix, path)
+	}
+	return false, nil
+}
+
+// List returns the hooks installed in dir, by name. Git's *.sample files
+// and chained hooks are not listed on their own.
+func List(dir string) ([]models.Hook, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var installed []models.Hook
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasSuffix(name, ".sample") || strings.HasSuffix(name, ChainedSuffix) {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0o111 == 0 {
+			continue
+		}
+
+		hook := models.Hook{Name: name}
+		if content, err := os.ReadFile(path); err == nil && isManaged(string(content)) {
+			hook.Managed = true
+			if definition, ok := Lookup(name); ok {
+				hook.Description = definition.Description
+			}
+		}
+		if _, err := os.Stat(path + ChainedSuffix); err == nil {
+			hook.Chained = true
+		}
+		installed = append(installed, hook)
+	}
+
+	sort.Slice(installed, func(i, j int) bool { return installed[i].Name < installed[j].Name })
+	return installed, nil
+}
+
+func isManaged(content string) bool {
+	return strings.Contains(content, Marker)
+}
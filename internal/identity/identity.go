@@ -0,0 +1,95 @@
+package identity
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/config"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// Resolver maps author identities to their canonical form using the aliases
+// from the glo config. .mailmap is applied by git itself, so the identities
+// given to a Resolver are already mailmapped.
+type Resolver struct {
+	aliases []config.Alias
+}
+
+func NewResolver(aliases []config.Alias) *Resolver {
+	return &Resolver{aliases: aliases}
+}
+
+// Resolve returns the canonical name and email for an identity. When several
+// aliases match, the last one wins, so repository aliases override user
+// ones. An alias without a name or email keeps the original value.
+func (r *Resolver) Resolve(name, email string) (string, string) {
+	for i := len(r.aliases) - 1; i >= 0; i-- {
+		alias := r.aliases[i]
+		if !matches(alias, name, email) {
+			continue
+		}
+		if alias.Name != "" {
+			name = alias.Name
+		}
+		if alias.Email != "" {
+			email = alias.Email
+		}
+		break
+	}
+	return name, email
+}
+
+// ApplyCommits resolves the author of each commit and the identities in its
+// trailers, including co-authors.
+func (r *Resolver) ApplyCommits(commits []models.Commit) {
+	for i := range commits {
+		commit := &commits[i]
+		commit.Author, commit.AuthorEmail = r.Resolve(commit.Author, commit.AuthorEmail)
+		for j := range commit.Trailers {
+			if commit.Trailers[j].IsIdentity() {
+				commit.Trailers[j].Name, commit.Trailers[j].Email = r.Resolve(commit.Trailers[j].Name, commit.Trailers[j].Email)
+			}
+		}
+		commit.CoAuthors = models.CoAuthorsFromTrailers(commit.Trailers)
+	}
+}
+
+func (r *Resolver) ApplyBlame(blame *models.BlameFile) {
+	for i := range blame.Ranges {
+		blame.Ranges[i].Author, blame.Ranges[i].AuthorEmail = r.Resolve(blame.Ranges[i].Author, blame.Ranges[i].AuthorEmail)
+	}
+}
+
+// AuthorPatterns returns extra git --author patterns for the spellings that
+// aliases map onto an author matching pattern, so that filtering by a
+// canonical name also finds commits recorded under its aliases.
+func (r *Resolver) AuthorPatterns(pattern string) []string {
+	var patterns []string
+	lower := strings.ToLower(pattern)
+
+	for _, alias := range r.aliases {
+		if !strings.Contains(strings.ToLower(alias.Name), lower) && !strings.Contains(strings.ToLower(alias.Email), lower) {
+			continue
+		}
+		if alias.Email != "" {
+			patterns = append(patterns, regexp.QuoteMeta(alias.Email))
+		}
+		for _, match := range alias.Match {
+			patterns = append(patterns, regexp.QuoteMeta(match))
+		}
+	}
+
+	return patterns
+}
+
+func matches(alias config.Alias, name, email string) bool {
+	if alias.Email != "" && strings.EqualFold(alias.Email, email) {
+		return true
+	}
+	for _, match := range alias.Match {
+		if strings.EqualFold(match, name) || strings.EqualFold(match, email) {
+			return true
+		}
+	}
+	return false
+}
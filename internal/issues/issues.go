@@ -0,0 +1,71 @@
+package issues
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// Build groups commits under each issue they reference, ordered by project
+// and issue number, keeping the order of the commits within each issue.
+func Build(commits []models.Commit) *models.IssueReport {
+	report := &models.IssueReport{TotalCommits: len(commits)}
+	index := make(map[string]int)
+
+	for _, commit := range commits {
+		linked := false
+		for _, reference := range commit.References {
+			if reference.Type != models.ReferenceIssue {
+				continue
+			}
+			linked = true
+
+			i, ok := index[reference.ID]
+			if !ok {
+				i = len(report.Issues)
+				index[reference.ID] = i
+				report.Issues = append(report.Issues, models.IssueCommits{Key: reference.ID, URL: reference.URL})
+			}
+			report.Issues[i].Commits = append(report.Issues[i].Commits, commit)
+		}
+		if !linked {
+			report.Unlinked = append(report.Unlinked, commit)
+		}
+	}
+
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return lessKey(report.Issues[i].Key, report.Issues[j].Key)
+	})
+
+	return report
+}
+
+// lessKey orders keys such as PROJ-9 before PROJ-10: by the part before the
+// last dash, then numerically by the part after it.
+func lessKey(a, b string) bool {
+	projectA, numberA := splitKey(a)
+	projectB, numberB := splitKey(b)
+	if projectA != projectB {
+		return projectA < projectB
+	}
+	if numberA != numberB {
+		return numberA < numberB
+	}
+	return a < b
+}
+
+// splitKey splits an issue key at its last dash into the project and the
+// number, or returns the whole key and -1 when it does not end in one.
+func splitKey(key string) (string, int) {
+	i := strings.LastIndex(key, "-")
+	if i < 0 {
+		return key, -1
+	}
+	number, err := strconv.Atoi(key[i+1:])
+	if err != nil {
+		return key, -1
+	}
+	return key[:i], number
+}
@@ -0,0 +1,230 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DinethDilhara/glo/internal/config"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// DefaultMaxSubjectLength is the longest subject the subject-length rule
+// allows unless configured otherwise.
+const DefaultMaxSubjectLength = 72
+
+// DefaultTypes are the commit types the conventional rule accepts.
+var DefaultTypes = []string{"build", "chore", "ci", "docs", "feat", "fix", "perf", "refactor", "revert", "style", "test"}
+
+// Rule is a check of a commit message. Default rules run unless disabled;
+// the others must be enabled.
+type Rule struct {
+	models.LintRule
+	Default bool
+	check   func(l *Linter, message Message) []string
+}
+
+// Rules are all the rules, in the order they are checked.
+var Rules = []Rule{
+	{models.LintRule{ID: "conventional", Description: "Subject follows Conventional Commits: type(scope): description", Level: models.LevelError}, false, checkConventional},
+	{models.LintRule{ID: "subject-length", Description: "Subject is present and not too long", Level: models.LevelError}, true, checkSubjectLength},
+	{models.LintRule{ID: "blank-line", Description: "A blank line separates the subject from the body", Level: models.LevelError}, true, checkBlankLine},
+	{models.LintRule{ID: "imperative", Description: "Subject is in the imperative mood (\"Add\", not \"Added\" or \"Adds\")", Level: models.LevelWarning}, true, checkImperative},
+	{models.LintRule{ID: "wip", Description: "No work-in-progress commits", Level: models.LevelError}, true, checkWIP},
+	{models.LintRule{ID: "fixup", Description: "No fixup!, squash! or amend! commits left to autosquash", Level: models.LevelError}, true, checkFixup},
+	{models.LintRule{ID: "required-trailers", Description: "Message has the required trailers", Level: models.LevelError}, false, checkRequiredTrailers},
+}
+
+// Message is a commit message to lint. Raw is the message as recorded or
+// typed, Trailers the trailers git finds in it.
+type Message struct {
+	Raw      string
+	Trailers []models.Trailer
+}
+
+// Subject is the first line of the message.
+func (m Message) Subject() string {
+	subject, _, _ := strings.Cut(m.Raw, "\n")
+	return strings.TrimSpace(subject)
+}
+
+type Linter struct {
+	rules            []Rule
+	maxSubjectLength int
+	types            []string
+	requiredTrailers []string
+}
+
+// New selects the rules to run: the default rules and those enabled in the
+// config or by enable, minus those disabled in the config or by disable.
+// Configuring required trailers enables the required-trailers rule.
+func New(cfg config.Lint, enable, disable []string) (*Linter, error) {
+	l := &Linter{
+		maxSubjectLength: cfg.MaxSubjectLength,
+		types:            cfg.Types,
+		requiredTrailers: cfg.RequiredTrailers,
+	}
+	if l.maxSubjectLength <= 0 {
+		l.maxSubjectLength = DefaultMaxSubjectLength
+	}
+	if len(l.types) == 0 {
+		l.types = DefaultTypes
+	}
+
+	enabled := append(slices.Clone(cfg.Enable), enable...)
+	if len(l.requiredTrailers) > 0 {
+		enabled = append(enabled, "required-trailers")
+	}
+	disabled := append(slices.Clone(cfg.Disable), disable...)
+	for _, id := range append(slices.Clone(enabled), disabled...) {
+		if !slices.ContainsFunc(Rules, func(rule Rule) bool { return rule.ID == id }) {
+			return nil, fmt.Errorf("unknown lint rule '%s'", id)
+		}
+	}
+
+	for _, rule := range Rules {
+		if (rule.Default || slices.Contains(enabled, rule.ID)) && !slices.Contains(disabled, rule.ID) {
+			l.rules = append(l.rules, rule)
+		}
+	}
+	if slices.ContainsFunc(l.rules, func(rule Rule) bool { return rule.ID == "required-trailers" }) && len(l.requiredTrailers) == 0 {
+		return nil, fmt.Errorf("the required-trailers rule needs \"required_trailers\" in the lint config")
+	}
+
+	return l, nil
+}
+
+// ActiveRules describes the rules the linter runs.
+func (l *Linter) ActiveRules() []models.LintRule {
+	rules := make([]models.LintRule, len(l.rules))
+	for i, rule := range l.rules {
+		rules[i] = rule.LintRule
+	}
+	return rules
+}
+
+// Lint checks a message against every active rule.
+func (l *Linter) Lint(message Message) []models.LintViolation {
+	var violations []models.LintViolation
+	for _, rule := range l.rules {
+		for _, problem := range rule.check(l, message) {
+			violations = append(violations, models.LintViolation{Rule: rule.ID, Level: rule.Level, Message: problem})
+		}
+	}
+	return violations
+}
+
+var conventionalSubject = regexp.MustCompile(`^([a-zA-Z]+)(\([^()]+\))?!?: \S`)
+
+func checkConventional(l *Linter, message Message) []string {
+	match := conventionalSubject.FindStringSubmatch(message.Subject())
+	if match == nil {
+		return []string{"subject is not in the form type(scope): description"}
+	}
+	if !slices.Contains(l.types, match[1]) {
+		return []string{fmt.Sprintf("unknown type '%s', expected one of %s", match[1], strings.Join(l.types, ", "))}
+	}
+	return nil
+}
+
+func checkSubjectLength(l *Linter, message Message) []string {
+	subject := message.Subject()
+	if subject == "" {
+		return []string{"subject is empty"}
+	}
+	if length := utf8.RuneCountInString(subject); length > l.maxSubjectLength {
+		return []string{fmt.Sprintf("subject is %d characters, more than %d", length, l.maxSubjectLength)}
+	}
+	return nil
+}
+
+func checkBlankLine(l *Linter, message Message) []string {
+	lines := strings.SplitN(message.Raw, "\n", 3)
+	if len(lines) > 1 && strings.TrimSpace(lines[1]) != "" {
+		return []string{"second line is not blank"}
+	}
+	return nil
+}
+
+func checkImperative(l *Linter, message Message) []string {
+	description := message.Subject()
+	if match := conventionalSubject.FindStringIndex(description); match != nil {
+		description = description[match[1]-1:]
+	}
+
+	word, _, _ := strings.Cut(strings.TrimSpace(description), " ")
+	word = strings.ToLower(strings.Trim(word, ".,:;!"))
+	if base, ok := nonImperative[word]; ok {
+		return []string{fmt.Sprintf("subject starts with '%s'; use the imperative '%s'", word, base)}
+	}
+	return nil
+}
+
+var wipSubject = regexp.MustCompile(`(?i)^(\[wip\]|wip\b)`)
+
+func checkWIP(l *Linter, message Message) []string {
+	if wipSubject.MatchString(message.Subject()) {
+		return []string{"work-in-progress commit"}
+	}
+	return nil
+}
+
+func checkFixup(l *Linter, message Message) []string {
+	subject := message.Subject()
+	for _, prefix := range []string{"fixup! ", "squash! ", "amend! "} {
+		if strings.HasPrefix(subject, prefix) {
+			return []string{fmt.Sprintf("%s commit should be squashed before merging", strings.TrimSuffix(prefix, " "))}
+		}
+	}
+	return nil
+}
+
+func checkRequiredTrailers(l *Linter, message Message) []string {
+	var problems []string
+	for _, key := range l.requiredTrailers {
+		found := slices.ContainsFunc(message.Trailers, func(trailer models.Trailer) bool {
+			return strings.EqualFold(trailer.Key, key)
+		})
+		if !found {
+			problems = append(problems, fmt.Sprintf("missing %s trailer", key))
+		}
+	}
+	return problems
+}
+
+// imperativeVerbs are verbs commit subjects commonly start with. Their past
+// tense, -ing and third-person forms are flagged by the imperative rule.
+var imperativeVerbs = []string{
+	"add", "allow", "avoid", "bump", "change", "clean", "convert", "correct",
+	"create", "delete", "disable", "document", "drop", "enable", "ensure",
+	"extract", "fix", "handle", "implement", "improve", "introduce", "merge",
+	"migrate", "move", "optimize", "prevent", "refactor", "remove", "rename",
+	"replace", "return", "revert", "set", "show", "simplify", "support",
+	"test", "update", "upgrade", "use", "write",
+}
+
+// nonImperative maps the other forms of imperativeVerbs to the verb.
+var nonImperative = func() map[string]string {
+	forms := make(map[string]string)
+	for _, verb := range imperativeVerbs {
+		stem := strings.TrimSuffix(verb, "e")
+		for _, form := range []string{verb + "s", verb + "es", verb + "d", verb + "ed", verb + "ing", stem + "ing", stem + "ed"} {
+			forms[form] = verb
+		}
+		if strings.HasSuffix(verb, "y") {
+			forms[verb[:len(verb)-1]+"ies"] = verb
+			forms[verb[:len(verb)-1]+"ied"] = verb
+		}
+		if last := verb[len(verb)-1]; len(verb) <= 4 && !strings.ContainsRune("aeiouwy", rune(last)) {
+			forms[verb+string(last)+"ed"] = verb
+			forms[verb+string(last)+"ing"] = verb
+		}
+	}
+	// Forms that are also imperatives or nouns are never flagged.
+	for _, verb := range imperativeVerbs {
+		delete(forms, verb)
+	}
+	return forms
+}()
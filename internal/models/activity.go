@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+type ActivityBucket struct {
+	Label string    `json:"label"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Count int       `json:"count"`
+}
+
+type ActivityReport struct {
+	Period       string           `json:"period"`
+	TotalCommits int              `json:"total_commits"`
+	First        time.Time        `json:"first"`
+	Last         time.Time        `json:"last"`
+	Days         []ActivityBucket `json:"days"`
+	Series       []ActivityBucket `json:"series"`
+	Weekdays     [7]int           `json:"weekdays"`
+	Hours        [24]int          `json:"hours"`
+	WeekdayHour  [7][24]int       `json:"weekday_hour"`
+	WeekendCount int              `json:"weekend_commits"`
+}
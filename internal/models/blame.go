@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+const UncommittedHash = "0000000000000000000000000000000000000000"
+
+// BlameRange is a run of consecutive lines last changed by the same commit.
+type BlameRange struct {
+	Hash        string    `json:"hash"`
+	Author      string    `json:"author"`
+	AuthorEmail string    `json:"author_email"`
+	Date        time.Time `json:"date"`
+	Summary     string    `json:"summary"`
+	StartLine   int       `json:"start_line"`
+	EndLine     int       `json:"end_line"`
+	Lines       []string  `json:"lines"`
+}
+
+type BlameFile struct {
+	Path       string       `json:"path"`
+	Revision   string       `json:"revision,omitempty"`
+	TotalLines int          `json:"total_lines"`
+	Ranges     []BlameRange `json:"ranges"`
+}
+
+type AuthorShare struct {
+	Author  string  `json:"author"`
+	Email   string  `json:"email"`
+	Lines   int     `json:"lines"`
+	Percent float64 `json:"percent"`
+}
+
+func (r BlameRange) IsCommitted() bool {
+	return r.Hash != UncommittedHash
+}
+
+// Ownership returns the share of lines each author last touched, largest
+// first.
+func (b *BlameFile) Ownership() []AuthorShare {
+	return b.LinesByAuthor().Shares(b.TotalLines)
+}
+
+func (b *BlameFile) LinesByAuthor() *AuthorTally {
+	lines := NewAuthorTally()
+	for _, r := range b.Ranges {
+		lines.Add(r.Author, r.AuthorEmail, len(r.Lines))
+	}
+	return lines
+}
@@ -1,11 +1,36 @@
 package models
 
+import "time"
+
 type Branch struct {
-	Name               string `json:"name"`
-	IsCurrent          bool   `json:"is_current"`
-	IsRemote           bool   `json:"is_remote"`
-	LastCommitHash     string `json:"last_commit_hash"`
-	LastCommitMessage  string `json:"last_commit_message"`
-	LastCommitAuthor   string `json:"last_commit_author"`
-	LastCommitDate     string `json:"last_commit_date"`
+	Name               string    `json:"name"`
+	IsCurrent          bool      `json:"is_current"`
+	IsRemote           bool      `json:"is_remote"`
+	// Remote is the remote a remote-tracking branch belongs to.
+	Remote             string    `json:"remote,omitempty"`
+	RefName            string    `json:"ref_name"`
+	LastCommitHash     string    `json:"last_commit_hash"`
+	LastCommitMessage  string    `json:"last_commit_message"`
+	LastCommitAuthor   string    `json:"last_commit_author"`
+	LastCommitDate     time.Time `json:"last_commit_date"`
+	Upstream           *Upstream `json:"upstream,omitempty"`
+	// DefaultBranch is the branch AheadDefault and BehindDefault count
+	// against. It is empty for the default branch itself.
+	DefaultBranch      string    `json:"default_branch,omitempty"`
+	AheadDefault       int       `json:"ahead_default"`
+	BehindDefault      int       `json:"behind_default"`
+}
+
+func (b Branch) UpstreamGone() bool {
+	return b.Upstream != nil && b.Upstream.Gone
+}
+
+// Upstream is the tracking state of a local branch against its configured
+// upstream. Gone means the upstream ref no longer exists, typically because
+// the remote branch was deleted and pruned.
+type Upstream struct {
+	Name   string `json:"name"`
+	Ahead  int    `json:"ahead"`
+	Behind int    `json:"behind"`
+	Gone   bool   `json:"gone,omitempty"`
 }
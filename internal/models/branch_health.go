@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+const (
+	BranchActive   = "active"
+	BranchMerged   = "merged"
+	BranchStale    = "stale"
+	BranchDiverged = "diverged"
+	BranchEmpty    = "empty"
+)
+
+// BranchHealth describes a local branch relative to the default branch
+// (Base) and to its upstream.
+type BranchHealth struct {
+	Name             string    `json:"name"`
+	IsCurrent        bool      `json:"is_current"`
+	Hash             string    `json:"hash"`
+	LastCommitDate   time.Time `json:"last_commit_date"`
+	LastCommitAuthor string    `json:"last_commit_author"`
+	AgeDays          int       `json:"age_days"`
+	AheadBase        int       `json:"ahead_base"`
+	BehindBase       int       `json:"behind_base"`
+	Merged           bool      `json:"merged"`
+	Upstream         *Upstream `json:"upstream,omitempty"`
+	Status           string    `json:"status"`
+	Reasons          []string  `json:"reasons,omitempty"`
+}
+
+type BranchHealthReport struct {
+	Base           string         `json:"base"`
+	StaleAfterDays int            `json:"stale_after_days"`
+	Counts         map[string]int `json:"counts"`
+	Branches       []BranchHealth `json:"branches"`
+}
+
+func (h BranchHealth) UpstreamGone() bool {
+	return h.Upstream != nil && h.Upstream.Gone
+}
@@ -1,8 +1,117 @@
 package models
 
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const (
+	SideLeft     = "left"
+	SideRight    = "right"
+	SideBoundary = "boundary"
+)
+
+// CoAuthorTrailer is the trailer crediting additional authors of a commit.
+const CoAuthorTrailer = "Co-authored-by"
+
+type Person struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// Trailer is a "Key: value" line at the end of a commit message. Name and
+// Email are set when the value is an identity such as "Jane <jane@x.org>".
+type Trailer struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// Commit holds the canonical author identity, after .mailmap and configured
+// aliases, in Author and AuthorEmail and the identity as recorded in the
+// commit in RawAuthor and RawAuthorEmail.
 type Commit struct {
-	Hash    string `json:"hash"`
-	Author  string `json:"author"`
-	Date    string `json:"date"`
-	Message string `json:"message"`
-}
\ No newline at end of file
+	Hash           string      `json:"hash"`
+	Parents        []string    `json:"parents,omitempty"`
+	Author         string      `json:"author"`
+	AuthorEmail    string      `json:"author_email,omitempty"`
+	RawAuthor      string      `json:"raw_author,omitempty"`
+	RawAuthorEmail string      `json:"raw_author_email,omitempty"`
+	Date           time.Time   `json:"date"`
+	Signature      *Signature  `json:"signature,omitempty"`
+	Message        string      `json:"message"`
+	Body           string      `json:"body,omitempty"`
+	Trailers       []Trailer   `json:"trailers,omitempty"`
+	CoAuthors      []Person    `json:"co_authors,omitempty"`
+	References     []Reference `json:"references,omitempty"`
+	Files          []string    `json:"files,omitempty"`
+	Paths          []string    `json:"paths,omitempty"`
+	Side           string      `json:"side,omitempty"`
+}
+
+// MergeGroup is a commit on the first-parent history of a branch with the
+// commits it merged in, the pull request view of a merge. Merged is empty
+// for commits that are not merges.
+type MergeGroup struct {
+	Commit Commit   `json:"commit"`
+	Merged []Commit `json:"merged"`
+}
+
+func (c Commit) IsMerge() bool {
+	return len(c.Parents) > 1
+}
+
+// MarshalJSON flags merge commits with "merge": true so that consumers need
+// not count parents.
+func (c Commit) MarshalJSON() ([]byte, error) {
+	type commit Commit
+	return json.Marshal(struct {
+		commit
+		Merge bool `json:"merge,omitempty"`
+	}{commit(c), c.IsMerge()})
+}
+
+func (c Commit) FullMessage() string {
+	if c.Body == "" {
+		return c.Message
+	}
+	return c.Message + "\n\n" + c.Body
+}
+
+func (t Trailer) IsIdentity() bool {
+	return t.Email != ""
+}
+
+// CoAuthorsFromTrailers returns the identities credited by Co-authored-by
+// trailers, in order and without duplicates.
+func CoAuthorsFromTrailers(trailers []Trailer) []Person {
+	var people []Person
+	seen := make(map[string]bool)
+	for _, trailer := range trailers {
+		if !strings.EqualFold(trailer.Key, CoAuthorTrailer) || trailer.Name == "" && trailer.Email == "" {
+			continue
+		}
+		key := IdentityKey(trailer.Name, trailer.Email)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		people = append(people, Person{Name: trailer.Name, Email: trailer.Email})
+	}
+	return people
+}
+
+// Authors returns the commit author followed by its co-authors, each
+// identity once.
+func (c Commit) Authors() []Person {
+	people := []Person{{Name: c.Author, Email: c.AuthorEmail}}
+	authorKey := IdentityKey(c.Author, c.AuthorEmail)
+	for _, coAuthor := range c.CoAuthors {
+		if IdentityKey(coAuthor.Name, coAuthor.Email) != authorKey {
+			people = append(people, coAuthor)
+		}
+	}
+	return people
+}
@@ -0,0 +1,32 @@
+package models
+
+// FileChange is one file of a diff stat. Binary files have no line counts.
+type FileChange struct {
+	Path      string `json:"path"`
+	OldPath   string `json:"old_path,omitempty"`
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+	Binary    bool   `json:"binary,omitempty"`
+}
+
+// Comparison describes how two revisions have diverged. LeftOnly holds the
+// commits reachable only from Left and RightOnly those reachable only from
+// Right; they may be cut short by a limit while LeftCount and RightCount
+// stay exact. Files is the change Right brings relative to the merge base.
+type Comparison struct {
+	Left       string       `json:"left"`
+	Right      string       `json:"right"`
+	MergeBase  string       `json:"merge_base"`
+	LeftCount  int          `json:"left_count"`
+	RightCount int          `json:"right_count"`
+	LeftOnly   []Commit     `json:"left_only"`
+	RightOnly  []Commit     `json:"right_only"`
+	Files      []FileChange `json:"files"`
+	Additions  int          `json:"additions"`
+	Deletions  int          `json:"deletions"`
+	// FastForward means Right can be merged into Left by fast-forwarding,
+	// UpToDate that Left already contains Right.
+	FastForward bool `json:"fast_forward"`
+	UpToDate    bool `json:"up_to_date"`
+}
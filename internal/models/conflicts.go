@@ -0,0 +1,37 @@
+package models
+
+// ConflictHunk is one conflicted region of a file in a trial merge. Line is
+// where the region starts in the merged file. Base is only set with the
+// diff3 and zdiff3 conflict styles.
+type ConflictHunk struct {
+	Line   int      `json:"line"`
+	Ours   []string `json:"ours"`
+	Base   []string `json:"base,omitempty"`
+	Theirs []string `json:"theirs"`
+}
+
+// ConflictFile is a file a merge would leave conflicted. Kinds are git's
+// conflict types such as "content", "add/add" or "modify/delete".
+type ConflictFile struct {
+	Path     string         `json:"path"`
+	Kinds    []string       `json:"kinds"`
+	Messages []string       `json:"messages"`
+	Hunks    []ConflictHunk `json:"hunks,omitempty"`
+}
+
+// MergePrediction is the outcome of merging Branch into Target. Error is set
+// when the trial merge could not be run at all, e.g. for unrelated histories.
+type MergePrediction struct {
+	Branch string         `json:"branch"`
+	Target string         `json:"target"`
+	Clean  bool           `json:"clean"`
+	Files  []ConflictFile `json:"files"`
+	Error  string         `json:"error,omitempty"`
+}
+
+type ConflictReport struct {
+	Target      string            `json:"target"`
+	Conflicting int               `json:"conflicting"`
+	Failed      int               `json:"failed,omitempty"`
+	Results     []MergePrediction `json:"results"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// Hook is an executable git hook. Managed hooks were written by glo hooks;
+// Chained means the hook glo replaced is kept and runs first.
+type Hook struct {
+	Name        string `json:"name"`
+	Managed     bool   `json:"managed"`
+	Chained     bool   `json:"chained,omitempty"`
+	Description string `json:"description,omitempty"`
+}
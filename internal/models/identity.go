@@ -0,0 +1,95 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// IdentityKey is the key authors are aggregated on: the email when there is
+// one, so that spelling variants of a name are counted once, otherwise the
+// name. Both are compared case-insensitively.
+func IdentityKey(name, email string) string {
+	if email != "" {
+		return strings.ToLower(email)
+	}
+	return strings.ToLower(name)
+}
+
+type AuthorCount struct {
+	Author string `json:"author"`
+	Email  string `json:"email,omitempty"`
+	Count  int    `json:"count"`
+}
+
+// AuthorTally counts per author identity. Each identity is displayed with
+// the first name and email added for it.
+type AuthorTally struct {
+	authors map[string]*AuthorCount
+}
+
+func NewAuthorTally() *AuthorTally {
+	return &AuthorTally{authors: make(map[string]*AuthorCount)}
+}
+
+func (t *AuthorTally) Add(name, email string, n int) {
+	key := IdentityKey(name, email)
+	count, ok := t.authors[key]
+	if !ok {
+		count = &AuthorCount{Author: name, Email: email}
+		t.authors[key] = count
+	}
+	count.Count += n
+}
+
+func (t *AuthorTally) Merge(other *AuthorTally) {
+	for _, count := range other.Ranked() {
+		t.Add(count.Author, count.Email, count.Count)
+	}
+}
+
+// Ranked returns the counts largest first, ties broken by name.
+func (t *AuthorTally) Ranked() []AuthorCount {
+	counts := make([]AuthorCount, 0, len(t.authors))
+	for _, count := range t.authors {
+		counts = append(counts, *count)
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Author < counts[j].Author
+	})
+
+	return counts
+}
+
+// Shares returns the counts as line shares of total, largest first.
+func (t *AuthorTally) Shares(total int) []AuthorShare {
+	ranked := t.Ranked()
+	shares := make([]AuthorShare, 0, len(ranked))
+	for _, count := range ranked {
+		share := AuthorShare{Author: count.Author, Email: count.Email, Lines: count.Count}
+		if total > 0 {
+			share.Percent = float64(count.Count) * 100 / float64(total)
+		}
+		shares = append(shares, share)
+	}
+	return shares
+}
+
+// CountCommitsByAuthor tallies commits per author identity. With coAuthors a
+// commit also counts once for each of its co-authors.
+func CountCommitsByAuthor(commits []Commit, coAuthors bool) *AuthorTally {
+	tally := NewAuthorTally()
+	for _, commit := range commits {
+		if !coAuthors {
+			tally.Add(commit.Author, commit.AuthorEmail, 1)
+			continue
+		}
+		for _, person := range commit.Authors() {
+			tally.Add(person.Name, person.Email, 1)
+		}
+	}
+	return tally
+}
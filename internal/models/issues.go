@@ -0,0 +1,17 @@
+package models
+
+// IssueCommits are the commits of a range that reference one issue.
+type IssueCommits struct {
+	Key     string   `json:"key"`
+	URL     string   `json:"url,omitempty"`
+	Commits []Commit `json:"commits"`
+}
+
+// IssueReport groups the commits of a range by the issues they reference.
+// A commit referencing several issues is listed under each; Unlinked holds
+// the commits that reference none.
+type IssueReport struct {
+	TotalCommits int            `json:"total_commits"`
+	Issues       []IssueCommits `json:"issues"`
+	Unlinked     []Commit       `json:"unlinked"`
+}
@@ -0,0 +1,55 @@
+package models
+
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+)
+
+// LintRule describes a glo lint rule. Rules with level warning are reported
+// but do not fail the lint.
+type LintRule struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Level       string `json:"level"`
+}
+
+type LintViolation struct {
+	Rule    string `json:"rule"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// LintResult is a commit message with the rules it breaks. Hash is empty
+// when the message is not committed yet, as in the commit-msg hook.
+type LintResult struct {
+	Hash       string          `json:"hash,omitempty"`
+	Subject    string          `json:"subject"`
+	Author     string          `json:"author,omitempty"`
+	Violations []LintViolation `json:"violations"`
+}
+
+// LintReport lists the messages with violations out of Checked messages,
+// checked against Rules. Merge commits are not linted.
+type LintReport struct {
+	Rules    []LintRule   `json:"rules"`
+	Checked  int          `json:"checked"`
+	Errors   int          `json:"errors"`
+	Warnings int          `json:"warnings"`
+	Results  []LintResult `json:"results"`
+}
+
+// Add records the violations of a message, if any.
+func (r *LintReport) Add(result LintResult) {
+	r.Checked++
+	if len(result.Violations) == 0 {
+		return
+	}
+	for _, violation := range result.Violations {
+		if violation.Level == LevelError {
+			r.Errors++
+		} else {
+			r.Warnings++
+		}
+	}
+	r.Results = append(r.Results, result)
+}
@@ -0,0 +1,37 @@
+package models
+
+// DirectoryOwnership aggregates blame across every file below a directory.
+// The CODEOWNERS fields are only filled in when a CODEOWNERS file is compared
+// against the blame data.
+type DirectoryOwnership struct {
+	Path          string        `json:"path"`
+	Files         int           `json:"files"`
+	TotalLines    int           `json:"total_lines"`
+	Owners        []AuthorShare `json:"owners"`
+	CodeOwners    []string      `json:"code_owners,omitempty"`
+	UnownedFiles  int           `json:"unowned_files,omitempty"`
+	StaleOwners   []string      `json:"stale_owners,omitempty"`
+	MissingOwners []string      `json:"missing_owners,omitempty"`
+}
+
+// SkippedFile is a file left out of an ownership report because it could
+// not be blamed.
+type SkippedFile struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+type OwnershipReport struct {
+	Revision       string               `json:"revision,omitempty"`
+	Paths          []string             `json:"paths,omitempty"`
+	CodeOwnersFile string               `json:"codeowners_file,omitempty"`
+	TotalFiles     int                  `json:"total_files"`
+	TotalLines     int                  `json:"total_lines"`
+	Owners         []AuthorShare        `json:"owners"`
+	Directories    []DirectoryOwnership `json:"directories"`
+	Skipped        []SkippedFile        `json:"skipped,omitempty"`
+}
+
+func (d DirectoryOwnership) HasIssues() bool {
+	return d.UnownedFiles > 0 || len(d.StaleOwners) > 0 || len(d.MissingOwners) > 0
+}
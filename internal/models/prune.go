@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// PruneCandidate is a local branch glo branch prune would delete.
+type PruneCandidate struct {
+	Name           string    `json:"name"`
+	Hash           string    `json:"hash"`
+	LastCommitDate time.Time `json:"last_commit_date"`
+	AgeDays        int       `json:"age_days"`
+	Upstream       string    `json:"upstream,omitempty"`
+	Reasons        []string  `json:"reasons"`
+}
+
+// PrunedBranch records the tip of a deleted branch so that it can be
+// restored.
+type PrunedBranch struct {
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Upstream  string    `json:"upstream,omitempty"`
+	Reasons   []string  `json:"reasons"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
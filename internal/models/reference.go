@@ -0,0 +1,45 @@
+package models
+
+const (
+	ReferencePullRequest = "pull_request"
+	ReferenceIssue       = "issue"
+)
+
+// Reference is a pull request or issue mentioned in a commit message. ID is
+// the pull request number or the issue key; URL is only set when a link
+// template is configured.
+type Reference struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	URL  string `json:"url,omitempty"`
+}
+
+// Label is how the reference is written in text: "#1234" for pull
+// requests and the key itself for issues.
+func (r Reference) Label() string {
+	if r.Type == ReferencePullRequest {
+		return "#" + r.ID
+	}
+	return r.ID
+}
+
+// PullRequests returns the numbers of the pull requests the commit
+// references.
+func (c Commit) PullRequests() []string {
+	return c.referenceIDs(ReferencePullRequest)
+}
+
+// Issues returns the keys of the issues the commit references.
+func (c Commit) Issues() []string {
+	return c.referenceIDs(ReferenceIssue)
+}
+
+func (c Commit) referenceIDs(kind string) []string {
+	var ids []string
+	for _, reference := range c.References {
+		if reference.Type == kind {
+			ids = append(ids, reference.ID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Reflog actions, the first word of a reflog message. Actions glo does not
+// know, such as "cherry-pick" or "am", are kept as git wrote them.
+const (
+	ReflogCommit   = "commit"
+	ReflogRebase   = "rebase"
+	ReflogReset    = "reset"
+	ReflogCheckout = "checkout"
+	ReflogMerge    = "merge"
+	ReflogPull     = "pull"
+	ReflogBranch   = "branch"
+)
+
+// ReflogEntry is one update of a ref: it moved from OldHash to NewHash.
+// Selector names the entry as git does, newest first, e.g. HEAD@{2}.
+// OldHash is all zeros when the ref was created.
+type ReflogEntry struct {
+	Selector string    `json:"selector"`
+	Index    int       `json:"index"`
+	OldHash  string    `json:"old_hash"`
+	NewHash  string    `json:"new_hash"`
+	Action   string    `json:"action"`
+	Message  string    `json:"message"`
+	Date     time.Time `json:"date"`
+}
+
+// Reflog is the history of a ref, newest entry first.
+type Reflog struct {
+	Ref     string        `json:"ref"`
+	Entries []ReflogEntry `json:"entries"`
+}
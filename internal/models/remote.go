@@ -0,0 +1,12 @@
+package models
+
+// Remote is a configured git remote. Branches counts its remote-tracking
+// branches and Tracking the local branches whose upstream is on it.
+type Remote struct {
+	Name       string `json:"name"`
+	FetchURL   string `json:"fetch_url"`
+	PushURL    string `json:"push_url"`
+	HeadBranch string `json:"head_branch,omitempty"`
+	Branches   int    `json:"branches"`
+	Tracking   int    `json:"tracking"`
+}
@@ -0,0 +1,81 @@
+package models
+
+// Signature codes as printed by git's %G?.
+const (
+	SignatureGood         = "G"
+	SignatureBad          = "B"
+	SignatureUntrusted    = "U"
+	SignatureExpired      = "X"
+	SignatureExpiredKey   = "Y"
+	SignatureRevokedKey   = "R"
+	SignatureUnverifiable = "E"
+	SignatureNone         = "N"
+)
+
+// Signature is the result of verifying a signed commit against the local
+// keyring. Code is git's %G? letter and Status its name; Signer, Key and
+// Fingerprint are empty when git cannot tell.
+type Signature struct {
+	Status      string `json:"status"`
+	Code        string `json:"code"`
+	Signer      string `json:"signer,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// SignatureStatus names a %G? code.
+func SignatureStatus(code string) string {
+	switch code {
+	case SignatureGood:
+		return "good"
+	case SignatureBad:
+		return "bad"
+	case SignatureUntrusted:
+		return "untrusted"
+	case SignatureExpired:
+		return "expired"
+	case SignatureExpiredKey:
+		return "expired-key"
+	case SignatureRevokedKey:
+		return "revoked-key"
+	case SignatureUnverifiable:
+		return "unverifiable"
+	case SignatureNone:
+		return "none"
+	default:
+		return "unknown"
+	}
+}
+
+// Label describes the signature for display, e.g. "signed" or
+// "bad signature".
+func (s *Signature) Label() string {
+	switch s.Code {
+	case SignatureGood:
+		return "signed"
+	case SignatureUntrusted:
+		return "signed, untrusted key"
+	case SignatureExpired:
+		return "signed, expired signature"
+	case SignatureExpiredKey:
+		return "signed, expired key"
+	case SignatureRevokedKey:
+		return "signed, revoked key"
+	case SignatureBad:
+		return "bad signature"
+	default:
+		return "unverified signature"
+	}
+}
+
+// Valid reports whether the signature is good and made by a trusted key.
+// A good signature by a key that is not trusted does not count: for SSH it
+// means the key is not in the allowed signers file.
+func (s *Signature) Valid() bool {
+	return s != nil && s.Code == SignatureGood
+}
+
+// HasValidSignature reports whether the commit carries a good signature.
+func (c Commit) HasValidSignature() bool {
+	return c.Signature.Valid()
+}
@@ -16,6 +16,7 @@ type RepositoryStatus struct {
 	Conflicts    []FileStatus `json:"conflicts"`
 	IsClean      bool         `json:"isClean"`
 	RemoteBranch string       `json:"remoteBranch,omitempty"`
+	Hooks        []Hook       `json:"hooks,omitempty"`
 }
 
 func (f FileStatus) GetStatusDescription() string {
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Tag is a tag and the commit it points to. Annotated tags have a tagger
+// and subject of their own and Date is when they were made; for lightweight
+// tags it is the date of the commit. Signature is only set for signed tags.
+type Tag struct {
+	Name      string     `json:"name"`
+	Commit    string     `json:"commit"`
+	Annotated bool       `json:"annotated"`
+	Tagger    string     `json:"tagger,omitempty"`
+	Date      time.Time  `json:"date"`
+	Subject   string     `json:"subject,omitempty"`
+	Signature *Signature `json:"signature,omitempty"`
+}
+
+// HasValidSignature reports whether the tag carries a good signature.
+func (t Tag) HasValidSignature() bool {
+	return t.Signature.Valid()
+}
@@ -0,0 +1,25 @@
+package models
+
+type TrailerValueCount struct {
+	Value string `json:"value"`
+	Email string `json:"email,omitempty"`
+	Count int    `json:"count"`
+}
+
+// TrailerUsage describes how one trailer key is used across commits. Commits
+// counts commits carrying the key at least once; Occurrences counts every
+// line.
+type TrailerUsage struct {
+	Key            string              `json:"key"`
+	Commits        int                 `json:"commits"`
+	Occurrences    int                 `json:"occurrences"`
+	Percent        float64             `json:"percent"`
+	DistinctValues int                 `json:"distinct_values"`
+	Values         []TrailerValueCount `json:"values"`
+}
+
+type TrailerReport struct {
+	TotalCommits        int            `json:"total_commits"`
+	CommitsWithTrailers int            `json:"commits_with_trailers"`
+	Keys                []TrailerUsage `json:"keys"`
+}
@@ -0,0 +1,137 @@
+package owners
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// CodeOwnersLocations are the places GitHub and GitLab look for a CODEOWNERS
+// file, in the order they are searched.
+var CodeOwnersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+type CodeOwnersRule struct {
+	Pattern string
+	Owners  []string
+	Line    int
+	re      *regexp.Regexp
+}
+
+type CodeOwners struct {
+	Path  string
+	Rules []CodeOwnersRule
+}
+
+// FindCodeOwners loads the first CODEOWNERS file found below root. It returns
+// nil without an error when the repository has none.
+func FindCodeOwners(root string) (*CodeOwners, error) {
+	for _, location := range CodeOwnersLocations {
+		path := filepath.Join(root, location)
+		if _, err := os.Stat(path); err == nil {
+			codeOwners, err := LoadCodeOwners(path)
+			if err != nil {
+				return nil, err
+			}
+			codeOwners.Path = location
+			return codeOwners, nil
+		}
+	}
+	return nil, nil
+}
+
+func LoadCodeOwners(path string) (*CodeOwners, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	codeOwners := &CodeOwners{Path: path}
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		// GitLab section headers: [Section], ^[Optional section] and
+		// [Section][2] for required approvals.
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") || strings.HasPrefix(line, "^[") {
+			continue
+		}
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		re, err := compilePattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid pattern '%s'", path, lineNumber, fields[0])
+		}
+		codeOwners.Rules = append(codeOwners.Rules, CodeOwnersRule{
+			Pattern: fields[0],
+			Owners:  fields[1:],
+			Line:    lineNumber,
+			re:      re,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return codeOwners, nil
+}
+
+// OwnersOf returns the owners of a path relative to the repository root. As
+// in CODEOWNERS itself the last matching rule wins, and a rule without owners
+// leaves the path unowned.
+func (c *CodeOwners) OwnersOf(file string) []string {
+	for i := len(c.Rules) - 1; i >= 0; i-- {
+		if c.Rules[i].re.MatchString(file) {
+			return c.Rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// compilePattern turns a gitignore-style CODEOWNERS pattern into a regular
+// expression over root-relative paths. Patterns containing a slash other
+// than a trailing one are anchored at the root; the rest match at any depth.
+// A pattern matching a directory also matches everything below it.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var expr strings.Builder
+	if anchored {
+		expr.WriteString("^")
+	} else {
+		expr.WriteString("^(.*/)?")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+		case pattern[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	if dirOnly {
+		expr.WriteString("/.*$")
+	} else {
+		expr.WriteString("(/.*)?$")
+	}
+
+	return regexp.Compile(expr.String())
+}
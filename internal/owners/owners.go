@@ -0,0 +1,253 @@
+package owners
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type Options struct {
+	// Depth is how many directory levels below each base path are reported
+	// separately; deeper files are rolled up into their ancestor.
+	Depth int
+	// Bases are the directories the report was requested for, relative to
+	// the current directory.
+	Bases []string
+	// Prefix is the current directory relative to the repository root, used
+	// to match files against CODEOWNERS.
+	Prefix     string
+	CodeOwners *CodeOwners
+	// StaleBelow is the share of lines, in percent, under which a declared
+	// owner counts as stale. MissingAbove is the share at or over which an
+	// undeclared author counts as a missing owner.
+	StaleBelow   float64
+	MissingAbove float64
+}
+
+// Collect blames files using at most workers concurrent git processes. The
+// results are returned in the order of files. Files that fail to blame, such
+// as submodules, are left out and returned as skipped.
+func Collect(files []string, workers int, blame func(path string) (*models.BlameFile, error)) ([]*models.BlameFile, []models.SkippedFile) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]*models.BlameFile, len(files))
+	errs := make([]error, len(files))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(files)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], errs[i] = blame(files[i])
+			}
+		}()
+	}
+
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var blames []*models.BlameFile
+	var skipped []models.SkippedFile
+	for i, err := range errs {
+		if err != nil {
+			skipped = append(skipped, models.SkippedFile{Path: files[i], Error: err.Error()})
+			continue
+		}
+		blames = append(blames, results[i])
+	}
+	return blames, skipped
+}
+
+type directoryTotals struct {
+	files      int
+	lines      int
+	byAuthor   *models.AuthorTally
+	codeOwners []string
+	unowned    int
+}
+
+func Build(blames []*models.BlameFile, opts Options) *models.OwnershipReport {
+	report := &models.OwnershipReport{Paths: opts.Bases}
+	if opts.CodeOwners != nil {
+		report.CodeOwnersFile = opts.CodeOwners.Path
+	}
+
+	overall := models.NewAuthorTally()
+	directories := make(map[string]*directoryTotals)
+
+	for _, blame := range blames {
+		key := directoryKey(blame.Path, opts.Bases, opts.Depth)
+		totals, ok := directories[key]
+		if !ok {
+			totals = &directoryTotals{byAuthor: models.NewAuthorTally()}
+			directories[key] = totals
+		}
+
+		totals.files++
+		totals.lines += blame.TotalLines
+		lines := blame.LinesByAuthor()
+		totals.byAuthor.Merge(lines)
+		overall.Merge(lines)
+
+		if opts.CodeOwners != nil {
+			declared := opts.CodeOwners.OwnersOf(opts.Prefix + blame.Path)
+			if len(declared) == 0 {
+				totals.unowned++
+			}
+			for _, owner := range declared {
+				if !containsFold(totals.codeOwners, owner) {
+					totals.codeOwners = append(totals.codeOwners, owner)
+				}
+			}
+		}
+
+		report.TotalFiles++
+		report.TotalLines += blame.TotalLines
+	}
+
+	report.Owners = overall.Shares(report.TotalLines)
+
+	for key, totals := range directories {
+		directory := models.DirectoryOwnership{
+			Path:       key,
+			Files:      totals.files,
+			TotalLines: totals.lines,
+			Owners:     totals.byAuthor.Shares(totals.lines),
+		}
+		if opts.CodeOwners != nil {
+			directory.CodeOwners = totals.codeOwners
+			directory.UnownedFiles = totals.unowned
+			compareOwners(&directory, opts)
+		}
+		report.Directories = append(report.Directories, directory)
+	}
+
+	sort.Slice(report.Directories, func(i, j int) bool {
+		return report.Directories[i].Path < report.Directories[j].Path
+	})
+
+	return report
+}
+
+// compareOwners flags declared owners who hold almost none of a directory's
+// lines and major authors who are not declared. Teams cannot be resolved to
+// authors, so a team is never stale and its presence suppresses missing
+// owner warnings.
+func compareOwners(directory *models.DirectoryOwnership, opts Options) {
+	hasTeam := false
+	for _, owner := range directory.CodeOwners {
+		if isTeam(owner) {
+			hasTeam = true
+			continue
+		}
+
+		share := 0.0
+		for _, author := range directory.Owners {
+			if ownerMatches(owner, author) {
+				share += author.Percent
+			}
+		}
+		if share < opts.StaleBelow {
+			directory.StaleOwners = append(directory.StaleOwners, owner)
+		}
+	}
+
+	if hasTeam {
+		return
+	}
+
+	for _, author := range directory.Owners {
+		if author.Percent < opts.MissingAbove {
+			break
+		}
+
+		declared := false
+		for _, owner := range directory.CodeOwners {
+			if ownerMatches(owner, author) {
+				declared = true
+				break
+			}
+		}
+		if !declared {
+			directory.MissingOwners = append(directory.MissingOwners, author.Author)
+		}
+	}
+}
+
+// ownerMatches reports whether a CODEOWNERS entry refers to an author. Email
+// entries are compared with the author email; @handles with the local part
+// of the email, GitHub noreply addresses and the author name without spaces.
+func ownerMatches(owner string, author models.AuthorShare) bool {
+	if !strings.HasPrefix(owner, "@") {
+		return strings.EqualFold(owner, author.Email)
+	}
+
+	handle := strings.ToLower(strings.TrimPrefix(owner, "@"))
+	email := strings.ToLower(author.Email)
+	local, domain, _ := strings.Cut(email, "@")
+
+	if domain == "users.noreply.github.com" {
+		if _, login, ok := strings.Cut(local, "+"); ok {
+			local = login
+		}
+	}
+	if local == handle {
+		return true
+	}
+
+	return strings.EqualFold(strings.ReplaceAll(author.Author, " ", ""), handle)
+}
+
+func isTeam(owner string) bool {
+	return strings.HasPrefix(owner, "@") && strings.Contains(owner, "/")
+}
+
+// directoryKey returns the directory a file is reported under: the base path
+// containing it followed by at most depth further directory levels.
+func directoryKey(file string, bases []string, depth int) string {
+	base := ""
+	for _, candidate := range bases {
+		candidate = strings.TrimSuffix(strings.TrimPrefix(candidate, "./"), "/")
+		if candidate == "." || candidate == "" {
+			continue
+		}
+		if strings.HasPrefix(file, candidate+"/") && len(candidate) > len(base) {
+			base = candidate
+		}
+	}
+
+	rest := file
+	var parts []string
+	if base != "" {
+		rest = strings.TrimPrefix(file, base+"/")
+		parts = append(parts, base)
+	}
+	if rest = path.Dir(rest); rest != "." {
+		levels := strings.Split(rest, "/")
+		parts = append(parts, levels[:min(depth, len(levels))]...)
+	}
+
+	if len(parts) == 0 {
+		return "."
+	}
+	return strings.Join(parts, "/")
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
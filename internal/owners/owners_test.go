@@ -0,0 +1,63 @@
+package owners
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+func TestLoadCodeOwnersSkipsSections(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "CODEOWNERS")
+	content := `# Owners
+* @org/all
+
+[Docs]
+docs/ @org/docs
+
+^[Optional] @org/reviewers
+internal/ @alice
+
+[Required][2] @org/leads
+cmd/ @bob # CLI
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	codeOwners, err := LoadCodeOwners(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var patterns []string
+	for _, rule := range codeOwners.Rules {
+		patterns = append(patterns, rule.Pattern)
+	}
+	if want := []string{"*", "docs/", "internal/", "cmd/"}; !slices.Equal(patterns, want) {
+		t.Errorf("patterns = %q, want %q", patterns, want)
+	}
+	if owners := codeOwners.OwnersOf("cmd/log.go"); !slices.Equal(owners, []string{"@bob"}) {
+		t.Errorf("OwnersOf(cmd/log.go) = %q, want [@bob]", owners)
+	}
+}
+
+func TestCollectSkipsFailedFiles(t *testing.T) {
+	files := []string{"a.go", "vendor/sub", "b.go"}
+	blames, skipped := Collect(files, 2, func(path string) (*models.BlameFile, error) {
+		if path == "vendor/sub" {
+			return nil, errors.New("no such path")
+		}
+		return &models.BlameFile{Path: path}, nil
+	})
+
+	if len(blames) != 2 || blames[0].Path != "a.go" || blames[1].Path != "b.go" {
+		t.Errorf("blames = %v, want a.go and b.go in order", blames)
+	}
+	if len(skipped) != 1 || skipped[0].Path != "vendor/sub" || skipped[0].Error != "no such path" {
+		t.Errorf("skipped = %v, want vendor/sub", skipped)
+	}
+}
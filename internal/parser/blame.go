@@ -0,0 +1,112 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type blameCommit struct {
+	author  string
+	email   string
+	date    time.Time
+	summary string
+}
+
+// ParseBlamePorcelain parses `git blame --porcelain` output and groups
+// consecutive lines from the same commit into ranges. Commit details are only
+// printed the first time a commit appears, so they are remembered by hash.
+func (p *Parser) ParseBlamePorcelain(output string) ([]models.BlameRange, error) {
+	var ranges []models.BlameRange
+
+	commits := make(map[string]*blameCommit)
+	var current *blameCommit
+	var hash string
+	var lineNumber int
+	var authorTime int64
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "\t") {
+			if current == nil {
+				return nil, fmt.Errorf("blame line without header")
+			}
+			ranges = appendBlameLine(ranges, hash, lineNumber, current, line[1:])
+			current = nil
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		if current == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 || !isObjectName(fields[0]) {
+				continue
+			}
+			hash = fields[0]
+			lineNumber, _ = strconv.Atoi(fields[2])
+			if commits[hash] == nil {
+				commits[hash] = &blameCommit{}
+			}
+			current = commits[hash]
+			continue
+		}
+
+		switch key {
+		case "author":
+			current.author = value
+		case "author-mail":
+			current.email = strings.Trim(value, "<>")
+		case "author-time":
+			authorTime, _ = strconv.ParseInt(value, 10, 64)
+		case "author-tz":
+			current.date = time.Unix(authorTime, 0).In(parseTimeZoneOffset(value))
+		case "summary":
+			current.summary = value
+		}
+	}
+
+	return ranges, nil
+}
+
+func appendBlameLine(ranges []models.BlameRange, hash string, lineNumber int, commit *blameCommit, content string) []models.BlameRange {
+	if n := len(ranges); n > 0 && ranges[n-1].Hash == hash && ranges[n-1].EndLine == lineNumber-1 {
+		ranges[n-1].EndLine = lineNumber
+		ranges[n-1].Lines = append(ranges[n-1].Lines, content)
+		return ranges
+	}
+
+	return append(ranges, models.BlameRange{
+		Hash:        hash,
+		Author:      commit.author,
+		AuthorEmail: commit.email,
+		Date:        commit.date,
+		Summary:     commit.summary,
+		StartLine:   lineNumber,
+		EndLine:     lineNumber,
+		Lines:       []string{content},
+	})
+}
+
+// isObjectName reports whether s is a full SHA-1 or SHA-256 object name.
+func isObjectName(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseTimeZoneOffset(tz string) *time.Location {
+	t, err := time.Parse("-0700", tz)
+	if err != nil {
+		return time.UTC
+	}
+	_, offset := t.Zone()
+	return time.FixedZone(tz, offset)
+}
@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"testing"
+	"time"
+)
+
+const blameOutput = `eb40d90876cd305b0da00eb744530b729fd73e9f 1 1 1
+author A
+author-mail <a@x>
+author-time 1700000000
+author-tz +0200
+committer A
+committer-mail <a@x>
+committer-time 1700000000
+committer-tz +0200
+summary base
+boundary
+filename f
+	a
+983619686208670f1d0a5551e13c69ffa08ad7b1 2 2 2
+author B Person
+author-mail <b@x>
+author-time 1700003600
+author-tz -0130
+committer A
+committer-mail <a@x>
+committer-time 1700003600
+committer-tz +0200
+summary main
+previous eb40d90876cd305b0da00eb744530b729fd73e9f f
+filename f
+	b2
+983619686208670f1d0a5551e13c69ffa08ad7b1 3 3
+	b3
+eb40d90876cd305b0da00eb744530b729fd73e9f 3 4 1
+	c
+`
+
+func TestParseBlamePorcelain(t *testing.T) {
+	ranges, err := NewParser().ParseBlamePorcelain(blameOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		hash       string
+		author     string
+		email      string
+		unix       int64
+		zone       int
+		summary    string
+		start, end int
+		lines      []string
+	}{
+		{"eb40d90876cd305b0da00eb744530b729fd73e9f", "A", "a@x", 1700000000, 2 * 3600, "base", 1, 1, []string{"a"}},
+		{"983619686208670f1d0a5551e13c69ffa08ad7b1", "B Person", "b@x", 1700003600, -90 * 60, "main", 2, 3, []string{"b2", "b3"}},
+		{"eb40d90876cd305b0da00eb744530b729fd73e9f", "A", "a@x", 1700000000, 2 * 3600, "base", 4, 4, []string{"c"}},
+	}
+
+	if len(ranges) != len(tests) {
+		t.Fatalf("got %d ranges, want %d: %+v", len(ranges), len(tests), ranges)
+	}
+	for i, tt := range tests {
+		r := ranges[i]
+		if r.Hash != tt.hash || r.Author != tt.author || r.AuthorEmail != tt.email || r.Summary != tt.summary {
+			t.Errorf("range %d = %s %q <%s> %q, want %s %q <%s> %q", i, r.Hash, r.Author, r.AuthorEmail, r.Summary, tt.hash, tt.author, tt.email, tt.summary)
+		}
+		if !r.Date.Equal(time.Unix(tt.unix, 0)) {
+			t.Errorf("range %d date = %v, want %v", i, r.Date, time.Unix(tt.unix, 0))
+		}
+		if _, offset := r.Date.Zone(); offset != tt.zone {
+			t.Errorf("range %d zone offset = %d, want %d", i, offset, tt.zone)
+		}
+		if r.StartLine != tt.start || r.EndLine != tt.end || len(r.Lines) != len(tt.lines) {
+			t.Errorf("range %d = lines %d-%d %q, want %d-%d %q", i, r.StartLine, r.EndLine, r.Lines, tt.start, tt.end, tt.lines)
+			continue
+		}
+		for j := range tt.lines {
+			if r.Lines[j] != tt.lines[j] {
+				t.Errorf("range %d line %d = %q, want %q", i, j, r.Lines[j], tt.lines[j])
+			}
+		}
+	}
+}
+
+func TestParseBlamePorcelainSHA256(t *testing.T) {
+	hash := "6f2d4b1c0e9a8d7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a39"
+	output := hash + " 1 1 2\nauthor A\nauthor-mail <a@x>\nauthor-time 1700000000\nauthor-tz +0000\nsummary base\nfilename f\n\ta\n" +
+		hash + " 2 2\n\tb\n"
+
+	ranges, err := NewParser().ParseBlamePorcelain(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ranges) != 1 || ranges[0].Hash != hash || ranges[0].StartLine != 1 || ranges[0].EndLine != 2 {
+		t.Errorf("got %+v, want one range of %s over lines 1-2", ranges, hash)
+	}
+}
+
+func TestParseBlamePorcelainWithoutHeader(t *testing.T) {
+	if _, err := NewParser().ParseBlamePorcelain("\torphan line\n"); err == nil {
+		t.Error("expected an error for a line without a header")
+	}
+}
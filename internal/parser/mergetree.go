@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// ParseMergeTree parses `git merge-tree --write-tree -z --name-only` output:
+// the resulting tree, the conflicted paths and, after an empty field, the
+// informational messages. Each message lists the paths it concerns; the
+// CONFLICT messages are attached to those paths. Returns the tree and the
+// conflicted files in the order git reports them.
+func (p *Parser) ParseMergeTree(output string) (string, []models.ConflictFile, error) {
+	fields := strings.Split(output, "\x00")
+	if len(fields) == 0 || fields[0] == "" {
+		return "", nil, fmt.Errorf("empty merge-tree output")
+	}
+	tree := fields[0]
+
+	var files []models.ConflictFile
+	index := make(map[string]int)
+
+	i := 1
+	for ; i < len(fields) && fields[i] != ""; i++ {
+		if _, seen := index[fields[i]]; !seen {
+			index[fields[i]] = len(files)
+			files = append(files, models.ConflictFile{Path: fields[i]})
+		}
+	}
+
+	for i++; i < len(fields); {
+		count, err := strconv.Atoi(fields[i])
+		if err != nil || i+count+2 >= len(fields) {
+			break
+		}
+		paths := fields[i+1 : i+1+count]
+		message := strings.TrimSpace(fields[i+count+2])
+		i += count + 3
+
+		kind, ok := conflictKind(message)
+		if !ok {
+			continue
+		}
+		for _, path := range paths {
+			j, found := index[path]
+			if !found {
+				j = len(files)
+				index[path] = j
+				files = append(files, models.ConflictFile{Path: path})
+			}
+			files[j].Messages = append(files[j].Messages, message)
+			if !contains(files[j].Kinds, kind) {
+				files[j].Kinds = append(files[j].Kinds, kind)
+			}
+		}
+	}
+
+	return tree, files, nil
+}
+
+// conflictKind extracts "modify/delete" from a message such as
+// "CONFLICT (modify/delete): d.txt deleted in main ...".
+func conflictKind(message string) (string, bool) {
+	rest, found := strings.CutPrefix(message, "CONFLICT (")
+	if !found {
+		return "", false
+	}
+	kind, _, found := strings.Cut(rest, ")")
+	return kind, found
+}
+
+// ParseConflictHunks finds the regions between conflict markers in the
+// content of a conflicted file.
+func (p *Parser) ParseConflictHunks(content string) []models.ConflictHunk {
+	var hunks []models.ConflictHunk
+	var current *models.ConflictHunk
+	var section *[]string
+
+	for n, line := range strings.Split(content, "\n") {
+		switch {
+		case strings.HasPrefix(line, "<<<<<<<"):
+			current = &models.ConflictHunk{Line: n + 1}
+			section = &current.Ours
+		case current == nil:
+			continue
+		case strings.HasPrefix(line, "|||||||"):
+			section = &current.Base
+		case strings.HasPrefix(line, "======="):
+			section = &current.Theirs
+		case strings.HasPrefix(line, ">>>>>>>"):
+			hunks = append(hunks, *current)
+			current = nil
+		default:
+			*section = append(*section, line)
+		}
+	}
+
+	return hunks
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
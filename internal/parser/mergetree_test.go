@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+func TestParseMergeTree(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		tree   string
+		files  []models.ConflictFile
+	}{
+		{
+			name:   "clean",
+			output: "329d352ef87a1e23000c4b1d850894dd27dabe2a\x00",
+			tree:   "329d352ef87a1e23000c4b1d850894dd27dabe2a",
+		},
+		{
+			name: "conflicts",
+			output: "329d352ef87a1e23000c4b1d850894dd27dabe2a\x00d\x00f\x00\x00" +
+				"1\x00d\x00CONFLICT (modify/delete)\x00CONFLICT (modify/delete): d deleted in side and modified in master.  Version master of d left in tree.\n\x00" +
+				"1\x00f\x00Auto-merging\x00Auto-merging f\n\x00" +
+				"1\x00f\x00CONFLICT (contents)\x00CONFLICT (content): Merge conflict in f\n\x00",
+			tree: "329d352ef87a1e23000c4b1d850894dd27dabe2a",
+			files: []models.ConflictFile{
+				{
+					Path:     "d",
+					Kinds:    []string{"modify/delete"},
+					Messages: []string{"CONFLICT (modify/delete): d deleted in side and modified in master.  Version master of d left in tree."},
+				},
+				{
+					Path:     "f",
+					Kinds:    []string{"content"},
+					Messages: []string{"CONFLICT (content): Merge conflict in f"},
+				},
+			},
+		},
+		{
+			name: "rename on both sides",
+			output: "4b825dc642cb6eb9a060e54bf8d69288fbee4904\x00a\x00\x00" +
+				"3\x00a\x00b\x00c\x00CONFLICT (rename/rename)\x00CONFLICT (rename/rename): a renamed to b in HEAD and to c in side.\n\x00",
+			tree: "4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+			files: []models.ConflictFile{
+				{Path: "a", Kinds: []string{"rename/rename"}, Messages: []string{"CONFLICT (rename/rename): a renamed to b in HEAD and to c in side."}},
+				{Path: "b", Kinds: []string{"rename/rename"}, Messages: []string{"CONFLICT (rename/rename): a renamed to b in HEAD and to c in side."}},
+				{Path: "c", Kinds: []string{"rename/rename"}, Messages: []string{"CONFLICT (rename/rename): a renamed to b in HEAD and to c in side."}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, files, err := NewParser().ParseMergeTree(tt.output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tree != tt.tree {
+				t.Errorf("tree = %q, want %q", tree, tt.tree)
+			}
+			if !reflect.DeepEqual(files, tt.files) {
+				t.Errorf("files = %+v, want %+v", files, tt.files)
+			}
+		})
+	}
+
+	if _, _, err := NewParser().ParseMergeTree(""); err == nil {
+		t.Error("expected an error for empty output")
+	}
+}
+
+func TestParseConflictHunks(t *testing.T) {
+	content := "a\n<<<<<<< master\nb2\n||||||| base\nb\n=======\nB\n>>>>>>> side\nc\n<<<<<<< master\n=======\nd\n>>>>>>> side\n"
+
+	want := []models.ConflictHunk{
+		{Line: 2, Ours: []string{"b2"}, Base: []string{"b"}, Theirs: []string{"B"}},
+		{Line: 10, Theirs: []string{"d"}},
+	}
+	if got := NewParser().ParseConflictHunks(content); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseConflictHunks = %+v, want %+v", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -13,39 +14,148 @@ func NewParser() *Parser {
 	return &Parser{}
 }
 
+// LogFormat is the git log --pretty format understood by ParseGitLogOutput.
+// Each commit starts with a record separator and its fields are separated by
+// unit separators, so subjects, bodies and trailers may contain any text. The
+// trailing separator keeps --name-only file lists apart from the trailers.
+// Authors are read both as recorded (%an/%ae) and as mapped by .mailmap
+// (%aN/%aE). The signature fields are left empty, as verifying every
+// signature is slow; SignedLogFormat fills them in.
+const LogFormat = "format:%x1e%m%x1f%H%x1f%P%x1f%an%x1f%ae%x1f%aN%x1f%aE%x1f%aI%x1f%x1f%x1f%x1f%x1f%s%x1f%b%x1f%(trailers:only,unfold)%x1f"
+
+// SignedLogFormat is LogFormat with signatures verified against the local
+// keyring (%G?, %GS, %GK, %GF).
+const SignedLogFormat = "format:%x1e%m%x1f%H%x1f%P%x1f%an%x1f%ae%x1f%aN%x1f%aE%x1f%aI%x1f%G?%x1f%GS%x1f%GK%x1f%GF%x1f%s%x1f%b%x1f%(trailers:only,unfold)%x1f"
+
+const logFieldCount = 16
+
 func (p *Parser) ParseGitLogOutput(output string) ([]models.Commit, error) {
 	var commits []models.Commit
-	lines := strings.Split(strings.TrimSpace(output), "\n")
-	
-	for _, line := range lines {
-		if strings.TrimSpace(line) == "" {
+
+	for _, record := range strings.Split(output, "\x1e") {
+		if strings.TrimSpace(record) == "" {
 			continue
 		}
-		
-		commit, err := p.parseCommitLine(line)
+
+		commit, err := p.parseCommitRecord(record)
 		if err != nil {
-			continue
+			return nil, err
 		}
 		commits = append(commits, commit)
 	}
-	
+
 	return commits, nil
 }
 
-func (p *Parser) parseCommitLine(line string) (models.Commit, error) {
-	parts := strings.SplitN(line, "|", 4)
-	if len(parts) < 4 {
-		return models.Commit{}, nil
+func (p *Parser) parseCommitRecord(record string) (models.Commit, error) {
+	parts := strings.Split(record, "\x1f")
+	if len(parts) < logFieldCount {
+		return models.Commit{}, fmt.Errorf("invalid commit record: expected %d fields, got %d", logFieldCount, len(parts))
 	}
-	
+
+	trailers := ParseTrailers(parts[14])
+
 	return models.Commit{
-		Hash:    strings.TrimSpace(parts[0]),
-		Author:  strings.TrimSpace(parts[1]),
-		Date:    strings.TrimSpace(parts[2]),
-		Message: strings.TrimSpace(parts[3]),
+		Side:           parseSide(parts[0]),
+		Hash:           strings.TrimSpace(parts[1]),
+		Parents:        strings.Fields(parts[2]),
+		RawAuthor:      strings.TrimSpace(parts[3]),
+		RawAuthorEmail: strings.TrimSpace(parts[4]),
+		Author:         strings.TrimSpace(parts[5]),
+		AuthorEmail:    strings.TrimSpace(parts[6]),
+		Date:           parseDate(parts[7]),
+		Signature:      parseSignature(parts[8], parts[9], parts[10], parts[11]),
+		Message:        strings.TrimSpace(parts[12]),
+		Body:           strings.TrimSpace(parts[13]),
+		Trailers:       trailers,
+		CoAuthors:      models.CoAuthorsFromTrailers(trailers),
+		Files:          parseFileList(parts[15]),
 	}, nil
 }
 
+// parseSide maps the %m mark to the side of a symmetric range the commit is
+// on. git prints ">" for every commit unless --left-right is given.
+func parseSide(mark string) string {
+	switch strings.TrimSpace(mark) {
+	case "<":
+		return models.SideLeft
+	case ">":
+		return models.SideRight
+	case "-":
+		return models.SideBoundary
+	default:
+		return ""
+	}
+}
+
+// parseSignature returns the verification result of a signed commit, or nil
+// when it is not signed (%G? is N).
+func parseSignature(code, signer, key, fingerprint string) *models.Signature {
+	code = strings.TrimSpace(code)
+	if code == "" || code == models.SignatureNone {
+		return nil
+	}
+	return &models.Signature{
+		Code:        code,
+		Status:      models.SignatureStatus(code),
+		Signer:      strings.TrimSpace(signer),
+		Key:         strings.TrimSpace(key),
+		Fingerprint: strings.TrimSpace(fingerprint),
+	}
+}
+
+// ParseTrailers parses "Key: value" lines as printed by %(trailers:only,unfold).
+func ParseTrailers(text string) []models.Trailer {
+	var trailers []models.Trailer
+
+	for _, line := range strings.Split(text, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found || strings.TrimSpace(key) == "" {
+			continue
+		}
+		trailer := models.Trailer{
+			Key:   strings.TrimSpace(key),
+			Value: strings.TrimSpace(value),
+		}
+		trailer.Name, trailer.Email = ParseIdentity(trailer.Value)
+		trailers = append(trailers, trailer)
+	}
+
+	return trailers
+}
+
+// ParseIdentity splits "Name <email>" into its parts. It returns empty
+// strings when value is not in that form.
+func ParseIdentity(value string) (string, string) {
+	open := strings.LastIndex(value, "<")
+	if open < 0 || !strings.HasSuffix(value, ">") {
+		return "", ""
+	}
+	email := strings.TrimSpace(value[open+1 : len(value)-1])
+	if !strings.Contains(email, "@") {
+		return "", ""
+	}
+	return strings.TrimSpace(value[:open]), email
+}
+
+func parseFileList(text string) []string {
+	var files []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files
+}
+
+func parseDate(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
 func (p *Parser) FilterCommits(commits []models.Commit, author, message string, since time.Time) []models.Commit {
 	var filtered []models.Commit
 	
@@ -59,11 +169,8 @@ func (p *Parser) FilterCommits(commits []models.Commit, author, message string,
 			continue
 		}
 		
-		if !since.IsZero() {
-			commitTime, err := time.Parse("2006-01-02 15:04:05 -0700", commit.Date)
-			if err == nil && commitTime.Before(since) {
-				continue
-			}
+		if !since.IsZero() && !commit.Date.IsZero() && commit.Date.Before(since) {
+			continue
 		}
 		
 		filtered = append(filtered, commit)
@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// ReflogFormat is the git log -g --date=raw format understood by
+// ParseReflog: the new hash, the selector carrying the time of the update,
+// e.g. HEAD@{1700000000 +0200}, and the message.
+const ReflogFormat = "%H%x1f%gd%x1f%gs"
+
+// ParseReflog parses git log -g output in ReflogFormat, newest entry first,
+// and names the entries ref@{n}. A ref's previous value is the new value of
+// the entry below it; for the oldest entry it is all zeros, as if the ref
+// had been created then.
+func (p *Parser) ParseReflog(ref, output string) ([]models.ReflogEntry, error) {
+	var entries []models.ReflogEntry
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "\x1f", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid reflog entry: %s", line)
+		}
+
+		start, end := strings.LastIndex(fields[1], "@{"), strings.LastIndex(fields[1], "}")
+		if start < 0 || end < start {
+			return nil, fmt.Errorf("invalid reflog entry: %s", line)
+		}
+		stamp := strings.Fields(fields[1][start+2 : end])
+		if len(stamp) != 2 {
+			return nil, fmt.Errorf("invalid reflog entry: %s", line)
+		}
+
+		index := len(entries)
+		entries = append(entries, models.ReflogEntry{
+			Selector: fmt.Sprintf("%s@{%d}", ref, index),
+			Index:    index,
+			NewHash:  fields[0],
+			Action:   reflogAction(fields[2]),
+			Message:  fields[2],
+			Date:     parseReflogTime(stamp[0], stamp[1]),
+		})
+	}
+
+	for i := range entries {
+		if i+1 < len(entries) {
+			entries[i].OldHash = entries[i+1].NewHash
+		} else {
+			entries[i].OldHash = strings.Repeat("0", len(entries[i].NewHash))
+		}
+	}
+	return entries, nil
+}
+
+// reflogAction returns the command that wrote a reflog message, its first
+// word: "rebase (pick): Fix x" and "rebase -i (finish): ..." are rebases,
+// "merge feature: Fast-forward" a merge.
+func reflogAction(message string) string {
+	prefix, _, _ := strings.Cut(message, ":")
+	action, _, _ := strings.Cut(strings.TrimSpace(prefix), " ")
+	return strings.TrimSpace(action)
+}
+
+// parseReflogTime reads a Unix timestamp and a "+0200" style zone.
+func parseReflogTime(seconds, zone string) time.Time {
+	unix, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	t := time.Unix(unix, 0)
+	if offset, err := time.Parse("-0700", zone); err == nil {
+		t = t.In(offset.Location())
+	}
+	return t
+}
@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+func TestParseReflog(t *testing.T) {
+	output := "ce8853d6275217c5d8d2efbab1081ef0385676b7\x1fHEAD@{1700000300 +0200}\x1frebase (finish): returning to refs/heads/feature\n" +
+		"1118efffbf21fe953df7f71831096e3c98413acb\x1fHEAD@{1700000200 +0200}\x1freset: moving to HEAD~1\n" +
+		"0abed9180066d029b71598174a294b856a0d3ec2\x1fHEAD@{1700000100 -0500}\x1fcommit (initial): first\n"
+
+	entries, err := NewParser().ParseReflog("HEAD", output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.ReflogEntry{
+		{Selector: "HEAD@{0}", Index: 0, OldHash: "1118efffbf21fe953df7f71831096e3c98413acb", NewHash: "ce8853d6275217c5d8d2efbab1081ef0385676b7", Action: "rebase", Message: "rebase (finish): returning to refs/heads/feature", Date: time.Unix(1700000300, 0)},
+		{Selector: "HEAD@{1}", Index: 1, OldHash: "0abed9180066d029b71598174a294b856a0d3ec2", NewHash: "1118efffbf21fe953df7f71831096e3c98413acb", Action: "reset", Message: "reset: moving to HEAD~1", Date: time.Unix(1700000200, 0)},
+		{Selector: "HEAD@{2}", Index: 2, OldHash: "0000000000000000000000000000000000000000", NewHash: "0abed9180066d029b71598174a294b856a0d3ec2", Action: "commit", Message: "commit (initial): first", Date: time.Unix(1700000100, 0)},
+	}
+
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for i := range want {
+		got := entries[i]
+		if !got.Date.Equal(want[i].Date) {
+			t.Errorf("entry %d date = %v, want %v", i, got.Date, want[i].Date)
+		}
+		got.Date = want[i].Date
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("entry %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+	if _, offset := entries[2].Date.Zone(); offset != -5*3600 {
+		t.Errorf("zone offset = %d, want %d", offset, -5*3600)
+	}
+}
+
+func TestReflogAction(t *testing.T) {
+	tests := map[string]string{
+		"commit: Fix x":                      "commit",
+		"commit (amend): Fix x":              "commit",
+		"rebase -i (pick): Fix x":            "rebase",
+		"merge feature: Fast-forward":        "merge",
+		"checkout: moving from main to x":    "checkout",
+		"pull --rebase origin main: updated": "pull",
+		"cherry-pick: Fix x":                 "cherry-pick",
+	}
+
+	for message, want := range tests {
+		if got := reflogAction(message); got != want {
+			t.Errorf("reflogAction(%q) = %q, want %q", message, got, want)
+		}
+	}
+}
+
+func TestParseReflogInvalid(t *testing.T) {
+	for _, output := range []string{"not a reflog line", "abc\x1fHEAD\x1fcommit: x", "abc\x1fHEAD@{1700000000}\x1fcommit: x"} {
+		if _, err := NewParser().ParseReflog("HEAD", output); err == nil {
+			t.Errorf("ParseReflog(%q): expected an error", output)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// sshGoodSignature matches ssh-keygen's verdict, which names the signer
+// only when it is in gpg.ssh.allowedSignersFile.
+var sshGoodSignature = regexp.MustCompile(`^Good "[^"]*" signature (?:for (.+) )?with \S+ key (\S+)`)
+
+// gnupgCodes maps GnuPG's verdicts to %G? codes.
+var gnupgCodes = map[string]string{
+	"GOODSIG":   models.SignatureGood,
+	"EXPSIG":    models.SignatureExpired,
+	"EXPKEYSIG": models.SignatureExpiredKey,
+	"REVKEYSIG": models.SignatureRevokedKey,
+	"BADSIG":    models.SignatureBad,
+}
+
+// ParseTagVerification reads the output of git verify-tag --raw for a signed
+// tag into the %G? code git would give a commit signed the same way. ok
+// reports whether git verify-tag succeeded. GnuPG prints status lines;
+// SSH signatures print ssh-keygen's messages.
+func ParseTagVerification(output string, ok bool) *models.Signature {
+	signature := &models.Signature{Code: models.SignatureBad}
+	trusted := true
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := sshGoodSignature.FindStringSubmatch(line); match != nil {
+			signature.Code, signature.Signer = models.SignatureGood, match[1]
+			signature.Key, signature.Fingerprint = match[2], match[2]
+			trusted = match[1] != ""
+			continue
+		}
+		if strings.Contains(line, "allowedSignersFile needs to be configured") {
+			signature.Code = models.SignatureUnverifiable
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(line, "[GNUPG:] "))
+		if len(fields) == 0 || !strings.HasPrefix(line, "[GNUPG:] ") {
+			continue
+		}
+		switch fields[0] {
+		case "GOODSIG", "EXPSIG", "EXPKEYSIG", "REVKEYSIG", "BADSIG":
+			signature.Code = gnupgCodes[fields[0]]
+			if len(fields) > 1 {
+				signature.Key = fields[1]
+			}
+			if len(fields) > 2 {
+				signature.Signer = strings.Join(fields[2:], " ")
+			}
+		case "ERRSIG":
+			signature.Code = models.SignatureUnverifiable
+			if len(fields) > 1 {
+				signature.Key = fields[1]
+			}
+		case "VALIDSIG":
+			if len(fields) > 1 {
+				signature.Fingerprint = fields[1]
+			}
+		case "TRUST_UNDEFINED", "TRUST_NEVER":
+			trusted = false
+		}
+	}
+
+	if signature.Code == models.SignatureGood && (!trusted || !ok) {
+		signature.Code = models.SignatureUntrusted
+	}
+	signature.Status = models.SignatureStatus(signature.Code)
+	return signature
+}
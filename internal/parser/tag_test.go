@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+func TestParseTagVerification(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		ok     bool
+		want   models.Signature
+	}{
+		{
+			name:   "ssh good",
+			output: `Good "git" signature for a@x with ED25519 key SHA256:9VGy7ZKU` + "\n",
+			ok:     true,
+			want:   models.Signature{Code: "G", Status: "good", Signer: "a@x", Key: "SHA256:9VGy7ZKU", Fingerprint: "SHA256:9VGy7ZKU"},
+		},
+		{
+			name:   "ssh signer not allowed",
+			output: `Good "git" signature with ED25519 key SHA256:9VGy7ZKU` + "\nNo principal matched.\n",
+			want:   models.Signature{Code: "U", Status: "untrusted", Key: "SHA256:9VGy7ZKU", Fingerprint: "SHA256:9VGy7ZKU"},
+		},
+		{
+			name:   "ssh not configured",
+			output: "error: gpg.ssh.allowedSignersFile needs to be configured and exist for ssh signature verification\n",
+			want:   models.Signature{Code: "E", Status: "unverifiable"},
+		},
+		{
+			name:   "ssh bad",
+			output: "Signature verification failed: incorrect signature\n",
+			want:   models.Signature{Code: "B", Status: "bad"},
+		},
+		{
+			name: "gpg good and trusted",
+			output: "[GNUPG:] NEWSIG\n[GNUPG:] GOODSIG 4AEE18F83AFDEB23 Jane Doe <jane@example.com>\n" +
+				"[GNUPG:] VALIDSIG 5DE3E0509C47EA3CF04A42D34AEE18F83AFDEB23 2024-01-01 1704067200\n[GNUPG:] TRUST_ULTIMATE 0 pgp\n",
+			ok:   true,
+			want: models.Signature{Code: "G", Status: "good", Signer: "Jane Doe <jane@example.com>", Key: "4AEE18F83AFDEB23", Fingerprint: "5DE3E0509C47EA3CF04A42D34AEE18F83AFDEB23"},
+		},
+		{
+			name:   "gpg good but untrusted",
+			output: "[GNUPG:] GOODSIG 4AEE18F83AFDEB23 Jane Doe <jane@example.com>\n[GNUPG:] TRUST_UNDEFINED 0 pgp\n",
+			ok:     true,
+			want:   models.Signature{Code: "U", Status: "untrusted", Signer: "Jane Doe <jane@example.com>", Key: "4AEE18F83AFDEB23"},
+		},
+		{
+			name:   "gpg expired key",
+			output: "[GNUPG:] EXPKEYSIG 4AEE18F83AFDEB23 Jane Doe <jane@example.com>\n",
+			want:   models.Signature{Code: "Y", Status: "expired-key", Signer: "Jane Doe <jane@example.com>", Key: "4AEE18F83AFDEB23"},
+		},
+		{
+			name:   "gpg missing key",
+			output: "[GNUPG:] ERRSIG 4AEE18F83AFDEB23 1 10 00 1704067200 9 -\n[GNUPG:] NO_PUBKEY 4AEE18F83AFDEB23\n",
+			want:   models.Signature{Code: "E", Status: "unverifiable", Key: "4AEE18F83AFDEB23"},
+		},
+		{
+			name:   "gpg bad",
+			output: "[GNUPG:] BADSIG 4AEE18F83AFDEB23 Jane Doe <jane@example.com>\n",
+			want:   models.Signature{Code: "B", Status: "bad", Signer: "Jane Doe <jane@example.com>", Key: "4AEE18F83AFDEB23"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseTagVerification(tt.output, tt.ok); *got != tt.want {
+				t.Errorf("ParseTagVerification = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package prune
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// DefaultProtected are the branches never pruned regardless of
+// configuration.
+var DefaultProtected = []string{"main", "master", "develop", "trunk", "release/*"}
+
+// LogFile is where deleted branch tips are recorded, relative to the git
+// directory.
+const LogFile = "glo/pruned-branches.jsonl"
+
+type Options struct {
+	Target        string
+	OlderThanDays int
+	Protected     []string
+	// Merged and Gone select which kinds of branches are pruned.
+	Merged bool
+	Gone   bool
+}
+
+// Candidates picks the branches to delete from an audit against the target
+// branch, classified by health.Classify: those fully merged into it or whose
+// upstream is gone, with no commits for at least OlderThanDays. A branch at
+// the tip of the target has no commits of its own but may just have been
+// created, so it does not count as merged. The target, the current branch and
+// protected branches are skipped; the names of protected branches that
+// would otherwise have been pruned are returned as well.
+func Candidates(branches []models.BranchHealth, opts Options) ([]models.PruneCandidate, []string) {
+	var candidates []models.PruneCandidate
+	var protected []string
+
+	for _, branch := range branches {
+		if branch.Name == opts.Target || branch.IsCurrent {
+			continue
+		}
+
+		var reasons []string
+		if opts.Merged && branch.Merged {
+			reasons = append(reasons, fmt.Sprintf("merged into %s", opts.Target))
+		}
+		if opts.Gone && branch.UpstreamGone() {
+			reasons = append(reasons, fmt.Sprintf("upstream %s is gone", branch.Upstream.Name))
+		}
+		if len(reasons) == 0 || branch.AgeDays < opts.OlderThanDays {
+			continue
+		}
+
+		if IsProtected(branch.Name, opts.Protected) {
+			protected = append(protected, branch.Name)
+			continue
+		}
+
+		candidate := models.PruneCandidate{
+			Name:           branch.Name,
+			Hash:           branch.Hash,
+			LastCommitDate: branch.LastCommitDate,
+			AgeDays:        branch.AgeDays,
+			Reasons:        reasons,
+		}
+		if branch.Upstream != nil {
+			candidate.Upstream = branch.Upstream.Name
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, protected
+}
+
+// IsProtected reports whether name matches one of the glob patterns. A
+// pattern ending in "/*" protects every branch below the prefix, so
+// release/* covers release/1.x/hotfix too.
+func IsProtected(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+		if dir, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(name, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Record appends the deleted branch to the log in gitDir.
+func Record(gitDir string, pruned models.PrunedBranch) error {
+	logPath := filepath.Join(gitDir, LogFile)
+	if err := os.MkdirAll(filepath.Dir(logPath), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	data, err := json.Marshal(pruned)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
+
+// History returns the recorded deletions in gitDir, oldest first.
+func History(gitDir string) ([]models.PrunedBranch, error) {
+	file, err := os.Open(filepath.Join(gitDir, LogFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var history []models.PrunedBranch
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var pruned models.PrunedBranch
+		if err := json.Unmarshal(scanner.Bytes(), &pruned); err != nil {
+			continue
+		}
+		history = append(history, pruned)
+	}
+	return history, scanner.Err()
+}
+
+// Latest returns the most recent deletion of the named branch.
+func Latest(history []models.PrunedBranch, name string) (models.PrunedBranch, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Name == name {
+			return history[i], true
+		}
+	}
+	return models.PrunedBranch{}, false
+}
+
+func NewRecord(candidate models.PruneCandidate, now time.Time) models.PrunedBranch {
+	return models.PrunedBranch{
+		Name:      candidate.Name,
+		Hash:      candidate.Hash,
+		Upstream:  candidate.Upstream,
+		Reasons:   candidate.Reasons,
+		DeletedAt: now,
+	}
+}
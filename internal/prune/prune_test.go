@@ -0,0 +1,58 @@
+package prune
+
+import (
+	"testing"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+func TestIsProtected(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"main", true},
+		{"release/1.2", true},
+		{"release/1.x/hotfix", true},
+		{"release", false},
+		{"releases/1.2", false},
+		{"feature/main", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsProtected(tt.name, DefaultProtected); got != tt.want {
+			t.Errorf("IsProtected(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	branches := []models.BranchHealth{
+		{Name: "main", AgeDays: 10},
+		{Name: "done", Merged: true, AheadBase: 0, BehindBase: 4, AgeDays: 10},
+		{Name: "new", AheadBase: 0, BehindBase: 0, AgeDays: 10},
+		{Name: "wip", AheadBase: 3, AgeDays: 10},
+		{Name: "gone", AheadBase: 3, AgeDays: 10, Upstream: &models.Upstream{Name: "origin/gone", Gone: true}},
+		{Name: "release/1.x/hotfix", Merged: true, BehindBase: 1, AgeDays: 10},
+		{Name: "recent", Merged: true, BehindBase: 1, AgeDays: 1},
+	}
+
+	candidates, protected := Candidates(branches, Options{
+		Target:        "main",
+		OlderThanDays: 7,
+		Protected:     DefaultProtected,
+		Merged:        true,
+		Gone:          true,
+	})
+
+	var names []string
+	for _, candidate := range candidates {
+		names = append(names, candidate.Name)
+	}
+	if len(names) != 2 || names[0] != "done" || names[1] != "gone" {
+		t.Errorf("candidates = %q, want [done gone]", names)
+	}
+	if len(protected) != 1 || protected[0] != "release/1.x/hotfix" {
+		t.Errorf("protected = %q, want [release/1.x/hotfix]", protected)
+	}
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOp
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == '"' || c == '\'':
+			value, next, err := readString(src, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value, pos: i})
+			i = next
+		case strings.HasPrefix(src[i:], "&&"):
+			tokens = append(tokens, token{kind: tokenAnd, text: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(src[i:], "||"):
+			tokens = append(tokens, token{kind: tokenOr, text: "||", pos: i})
+			i += 2
+		case strings.ContainsRune("=!~<>", rune(c)):
+			op := readOp(src[i:])
+			kind := tokenOp
+			if op == "!" {
+				kind = tokenNot
+			}
+			tokens = append(tokens, token{kind: kind, text: op, pos: i})
+			i += len(op)
+		case isWordChar(rune(c)):
+			start := i
+			for i < len(src) && isWordChar(rune(src[i])) {
+				i++
+			}
+			word := src[start:i]
+			switch strings.ToLower(word) {
+			case "and":
+				tokens = append(tokens, token{kind: tokenAnd, text: word, pos: start})
+			case "or":
+				tokens = append(tokens, token{kind: tokenOr, text: word, pos: start})
+			case "not":
+				tokens = append(tokens, token{kind: tokenNot, text: word, pos: start})
+			default:
+				tokens = append(tokens, token{kind: tokenWord, text: word, pos: start})
+			}
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' at position %d", c, i+1)
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(src)})
+	return tokens, nil
+}
+
+func readOp(src string) string {
+	for _, op := range []string{"==", "!=", "!~", "<=", ">=", "=", "~", "<", ">", "!"} {
+		if strings.HasPrefix(src, op) {
+			return op
+		}
+	}
+	return src[:1]
+}
+
+func readString(src string, start int) (string, int, error) {
+	quote := src[start]
+	var value strings.Builder
+
+	for i := start + 1; i < len(src); i++ {
+		switch {
+		case src[i] == '\\' && i+1 < len(src) && (src[i+1] == quote || src[i+1] == '\\'):
+			value.WriteByte(src[i+1])
+			i++
+		case src[i] == quote:
+			return value.String(), i + 1, nil
+		default:
+			value.WriteByte(src[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string starting at position %d", start+1)
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_-.:/@+*#", r) || r > unicode.MaxASCII
+}
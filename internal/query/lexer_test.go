@@ -0,0 +1,70 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func describeTokens(tokens []token) []string {
+	names := map[tokenKind]string{
+		tokenEOF: "eof", tokenWord: "word", tokenString: "string", tokenOp: "op",
+		tokenAnd: "and", tokenOr: "or", tokenNot: "not", tokenLParen: "(", tokenRParen: ")",
+	}
+	described := make([]string, len(tokens))
+	for i, t := range tokens {
+		described[i] = fmt.Sprintf("%s:%s@%d", names[t.kind], t.text, t.pos)
+	}
+	return described
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"", "eof:@0"},
+		{"author=alice", "word:author@0 op:=@6 word:alice@7 eof:@12"},
+		{"author == 'Jane Doe'", "word:author@0 op:==@7 string:Jane Doe@10 eof:@20"},
+		{`message ~ "say \"hi\""`, `word:message@0 op:~@8 string:say "hi"@10 eof:@22`},
+		{`path='a\\b'`, `word:path@0 op:=@4 string:a\b@5 eof:@11`},
+		{"a!=b c!~d e<=f g>=h i<j k>l", "word:a@0 op:!=@1 word:b@3 word:c@5 op:!~@6 word:d@8 word:e@10 op:<=@11 word:f@13 word:g@15 op:>=@16 word:h@18 word:i@20 op:<@21 word:j@22 word:k@24 op:>@25 word:l@26 eof:@27"},
+		{"!merge && (a=1 || b=2)", "not:!@0 word:merge@1 and:&&@7 (:(@10 word:a@11 op:=@12 word:1@13 or:||@15 word:b@18 op:=@19 word:2@20 ):)@21 eof:@22"},
+		{"NOT merge AND x Or y", "not:NOT@0 word:merge@4 and:AND@10 word:x@14 or:Or@16 word:y@19 eof:@20"},
+		{"trailer:co-authored-by", "word:trailer:co-authored-by@0 eof:@22"},
+		{"path=src/*.go date>2025-W14 email=a+b@x.io", "word:path@0 op:=@4 word:src/*.go@5 word:date@14 op:>@18 word:2025-W14@19 word:email@28 op:=@33 word:a+b@x.io@34 eof:@42"},
+		{"author=zoë", "word:author@0 op:=@6 word:zoë@7 eof:@11"},
+		{"android", "word:android@0 eof:@7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			tokens, err := tokenize(tt.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Join(describeTokens(tokens), " "); got != tt.want {
+				t.Errorf("tokenize(%q) =\n  %s\nwant\n  %s", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	tests := []struct {
+		src     string
+		message string
+	}{
+		{`author="alice`, "unterminated string starting at position 8"},
+		{"author='a\\'", "unterminated string"},
+		{"author=a; drop", "unexpected character ';' at position 9"},
+		{"a=$b", "unexpected character '$' at position 3"},
+	}
+
+	for _, tt := range tests {
+		_, err := tokenize(tt.src)
+		if err == nil || !strings.Contains(err.Error(), tt.message) {
+			t.Errorf("tokenize(%q) error = %v, want it to mention %q", tt.src, err, tt.message)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/dateparse"
+)
+
+type queryParser struct {
+	tokens []token
+	pos    int
+	now    time.Time
+	sprint *dateparse.Sprint
+}
+
+func (p *queryParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *queryParser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *queryParser) parseUnary() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNot:
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{inner: inner}, nil
+	case tokenLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, fmt.Errorf("expected ')' at position %d", closing.pos+1)
+		}
+		return inner, nil
+	case tokenWord:
+		return p.parsePredicate(t)
+	case tokenEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	default:
+		return nil, fmt.Errorf("unexpected '%s' at position %d", t.text, t.pos+1)
+	}
+}
+
+func (p *queryParser) parsePredicate(fieldToken token) (node, error) {
+	field, key, _ := strings.Cut(strings.ToLower(fieldToken.text), ":")
+	if field == "msg" {
+		field = "message"
+	}
+	pred := &predicate{field: field, key: key}
+
+	switch field {
+	case "author", "email", "message", "subject", "body", "path", "date", "merge", "branch":
+		if key != "" {
+			return nil, fmt.Errorf("field '%s' does not take a key", field)
+		}
+	case "trailer":
+		if key == "" {
+			return nil, fmt.Errorf("trailer needs a key, e.g. trailer:co-authored-by")
+		}
+	default:
+		return nil, fmt.Errorf("unknown field '%s'. Use: %s", fieldToken.text, Fields)
+	}
+
+	if p.peek().kind != tokenOp {
+		switch field {
+		case "merge":
+			pred.op, pred.boolValue = "=", true
+			return pred, nil
+		case "trailer":
+			return pred, nil
+		}
+		return nil, fmt.Errorf("expected an operator after '%s'", fieldToken.text)
+	}
+
+	pred.op = p.next().text
+	if pred.op == "==" {
+		pred.op = "="
+	}
+
+	valueToken := p.next()
+	if valueToken.kind != tokenWord && valueToken.kind != tokenString {
+		return nil, fmt.Errorf("expected a value after '%s %s'", fieldToken.text, pred.op)
+	}
+	pred.value = valueToken.text
+
+	if err := p.checkPredicate(pred); err != nil {
+		return nil, err
+	}
+	return pred, nil
+}
+
+func (p *queryParser) checkPredicate(pred *predicate) error {
+	ordering := pred.op == "<" || pred.op == "<=" || pred.op == ">" || pred.op == ">="
+	regex := pred.op == "~" || pred.op == "!~"
+
+	switch pred.field {
+	case "date":
+		if regex {
+			return fmt.Errorf("date does not support '%s'", pred.op)
+		}
+		dates, err := dateparse.Parse(pred.value, p.now, p.sprint)
+		if err != nil {
+			return err
+		}
+		pred.dates = dates
+		return nil
+	case "merge":
+		if pred.op != "=" && pred.op != "!=" {
+			return fmt.Errorf("merge only supports = and !=")
+		}
+		switch strings.ToLower(pred.value) {
+		case "true", "yes", "1":
+			pred.boolValue = true
+		case "false", "no", "0":
+			pred.boolValue = false
+		default:
+			return fmt.Errorf("merge must be compared with true or false, not '%s'", pred.value)
+		}
+		return nil
+	case "branch":
+		if pred.op != "=" && pred.op != "!=" {
+			return fmt.Errorf("branch only supports = and !=")
+		}
+		return nil
+	}
+
+	if ordering {
+		return fmt.Errorf("%s does not support '%s'", pred.field, pred.op)
+	}
+	if regex {
+		re, err := regexp.Compile("(?i)" + pred.value)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression '%s': %v", pred.value, err)
+		}
+		pred.re = re
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2025, time.July, 16, 15, 30, 0, 0, time.UTC)
+
+// describe prints a parsed expression with every operator parenthesized.
+func describe(n node) string {
+	switch n := n.(type) {
+	case *andNode:
+		return fmt.Sprintf("(%s and %s)", describe(n.left), describe(n.right))
+	case *orNode:
+		return fmt.Sprintf("(%s or %s)", describe(n.left), describe(n.right))
+	case *notNode:
+		return "not " + describe(n.inner)
+	case *predicate:
+		name := n.field
+		if n.key != "" {
+			name += ":" + n.key
+		}
+		if n.value == "" {
+			return name
+		}
+		return name + n.op + n.value
+	}
+	return "?"
+}
+
+func TestParsePrecedence(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"author=a", "author=a"},
+		{"author=a or email=b and subject=c", "(author=a or (email=b and subject=c))"},
+		{"author=a and email=b or subject=c", "((author=a and email=b) or subject=c)"},
+		{"(author=a or email=b) and subject=c", "((author=a or email=b) and subject=c)"},
+		{"author=a and email=b and subject=c", "((author=a and email=b) and subject=c)"},
+		{"author=a or email=b or subject=c", "((author=a or email=b) or subject=c)"},
+		{"not merge and author=a", "(not merge and author=a)"},
+		{"not (merge or author=a)", "not (merge or author=a)"},
+		{"not not merge", "not not merge"},
+		{"author=a || email=b && !subject~c", "(author=a or (email=b and not subject~c))"},
+		{"((author=a))", "author=a"},
+		{`Trailer:Co-Authored-By and author == "Jane Doe"`, "(trailer:co-authored-by and author=Jane Doe)"},
+		{"msg ~ '^fix'", "message~^fix"},
+		{"merge = false or merge != yes", "(merge=false or merge!=yes)"},
+		{"date >= 'last month' and path = cmd/", "(date>=last month and path=cmd/)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			q, err := Parse(tt.src, now, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := describe(q.root); got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		src     string
+		message string
+	}{
+		{"", "unexpected end of expression"},
+		{"author", "expected an operator after 'author'"},
+		{"author =", "expected a value after 'author ='"},
+		{"author = and", "expected a value"},
+		{"(author=a", "expected ')'"},
+		{"author=a)", "unexpected ')' at position 9"},
+		{"author=a email=b", "unexpected 'email' at position 10"},
+		{"and author=a", "unexpected 'and' at position 1"},
+		{"bogus=1", "unknown field 'bogus'"},
+		{"author:x=y", "field 'author' does not take a key"},
+		{"trailer:=x", "trailer needs a key"},
+		{"date ~ 2025", "date does not support '~'"},
+		{"date = someday", "cannot parse"},
+		{"date = 'this sprint'", "sprint cadence"},
+		{"merge ~ yes", "merge only supports = and !="},
+		{"merge = maybe", "merge must be compared with true or false"},
+		{"branch < main", "branch only supports = and !="},
+		{"author < b", "author does not support '<'"},
+		{"message ~ '('", "invalid regular expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			_, err := Parse(tt.src, now, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("Parse(%q) error = %v, want it to mention %q", tt.src, err, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/dateparse"
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+const Fields = "author, email, message, subject, body, path, date, merge, branch, trailer:<key>"
+
+// Query is a compiled --where expression. Expressions combine predicates of
+// the form "field op value" with and/or/not and parentheses, e.g.
+//
+//	author ~ "alice|bob" and not merge and (path = src/ or message ~ "^fix")
+//
+// Operators are = and != (case-insensitive equality), ~ and !~ (regular
+// expressions) and <, <=, >, >= for dates. "merge" and "trailer:<key>" may be
+// used on their own to test for merge commits and trailer presence.
+type Query struct {
+	root       node
+	branches   map[string]map[string]bool
+	pathPrefix string
+}
+
+// Parse compiles a --where expression. Dates are resolved relative to now,
+// and sprints with sprint when it is configured.
+func Parse(src string, now time.Time, sprint *dateparse.Sprint) (*Query, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{tokens: tokens, now: now, sprint: sprint}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected '%s' at position %d", p.peek().text, p.peek().pos+1)
+	}
+
+	return &Query{root: root, branches: make(map[string]map[string]bool)}, nil
+}
+
+// NeedsFiles reports whether evaluating the query requires each commit's list
+// of changed files.
+func (q *Query) NeedsFiles() bool {
+	found := false
+	walk(q.root, func(p *predicate) {
+		if p.field == "path" {
+			found = true
+		}
+	})
+	return found
+}
+
+// SetPathPrefix sets the current directory relative to the repository root.
+// Path values are relative to it, like pathspecs, while the changed files
+// of commits are relative to the root.
+func (q *Query) SetPathPrefix(prefix string) {
+	q.pathPrefix = prefix
+}
+
+// ResolveBranches looks up the commits reachable from every branch the query
+// tests with "branch = <name>".
+func (q *Query) ResolveBranches(resolve func(ref string) (map[string]bool, error)) error {
+	var err error
+	walk(q.root, func(p *predicate) {
+		if p.field != "branch" || err != nil {
+			return
+		}
+		if _, ok := q.branches[p.value]; ok {
+			return
+		}
+		q.branches[p.value], err = resolve(p.value)
+	})
+	return err
+}
+
+// Apply narrows opts with git arguments for the parts of the query git can
+// evaluate itself. Only top-level conjuncts are pushed down and every pushed
+// filter is at least as wide as the predicate it comes from, so Match must
+// still be applied to the result.
+func (q *Query) Apply(opts *gitexec.LogOptions) {
+	authorFlag := opts.Author != ""
+	pathspecs := len(opts.Paths) > 0
+	ignoreCase := false
+
+	for _, conjunct := range conjuncts(q.root) {
+		p, ok := conjunct.(*predicate)
+		if !ok {
+			continue
+		}
+
+		switch p.field {
+		case "author":
+			if !authorFlag && opts.Author == "" && p.isLiteralMatch() {
+				opts.Author = p.value
+				ignoreCase = true
+			}
+		case "message", "subject", "body":
+			if !authorFlag && p.isLiteralMatch() {
+				opts.Args = append(opts.Args, "--grep="+p.value)
+				ignoreCase = true
+			}
+		case "merge":
+			if p.op == "=" && p.boolValue || p.op == "!=" && !p.boolValue {
+				opts.Args = append(opts.Args, "--merges")
+			} else {
+				opts.Args = append(opts.Args, "--no-merges")
+			}
+		case "path":
+			if p.op == "=" && !pathspecs {
+				opts.Paths = append(opts.Paths, p.value)
+			}
+		}
+	}
+
+	if ignoreCase {
+		opts.Args = append(opts.Args, "--regexp-ignore-case")
+	}
+	if q.NeedsFiles() {
+		opts.WithFiles = true
+		if len(opts.Paths) > 0 {
+			opts.Args = append(opts.Args, "--full-diff")
+		}
+	}
+}
+
+func (q *Query) Match(commit *models.Commit) bool {
+	return q.root.eval(commit, q)
+}
+
+func (q *Query) Filter(commits []models.Commit) []models.Commit {
+	var filtered []models.Commit
+	for i := range commits {
+		if q.Match(&commits[i]) {
+			filtered = append(filtered, commits[i])
+		}
+	}
+	return filtered
+}
+
+type node interface {
+	eval(commit *models.Commit, q *Query) bool
+}
+
+type andNode struct{ left, right node }
+type orNode struct{ left, right node }
+type notNode struct{ inner node }
+
+func (n *andNode) eval(c *models.Commit, q *Query) bool {
+	return n.left.eval(c, q) && n.right.eval(c, q)
+}
+
+func (n *orNode) eval(c *models.Commit, q *Query) bool {
+	return n.left.eval(c, q) || n.right.eval(c, q)
+}
+
+func (n *notNode) eval(c *models.Commit, q *Query) bool {
+	return !n.inner.eval(c, q)
+}
+
+type predicate struct {
+	field     string
+	key       string
+	op        string
+	value     string
+	re        *regexp.Regexp
+	dates     dateparse.Range
+	boolValue bool
+}
+
+func (p *predicate) isLiteralMatch() bool {
+	return (p.op == "=" || p.op == "~") && p.value != "" && regexp.QuoteMeta(p.value) == p.value
+}
+
+func (p *predicate) eval(c *models.Commit, q *Query) bool {
+	switch p.field {
+	case "author":
+		return p.matchAny([]string{c.Author, c.RawAuthor}, p.matchValue)
+	case "email":
+		return p.matchAny([]string{c.AuthorEmail, c.RawAuthorEmail}, p.matchValue)
+	case "message":
+		return p.matchString(c.FullMessage())
+	case "subject":
+		return p.matchString(c.Message)
+	case "body":
+		return p.matchString(c.Body)
+	case "date":
+		return p.matchDate(c.Date)
+	case "merge":
+		return (c.IsMerge() == p.boolValue) == (p.op == "=")
+	case "branch":
+		return q.branches[p.value][c.Hash] == (p.op == "=")
+	case "path":
+		return p.matchAny(c.Files, func(file string) bool {
+			if p.re != nil {
+				return p.re.MatchString(file)
+			}
+			return gitexec.MatchPathspec(gitexec.ResolvePathspec(p.value, q.pathPrefix), file)
+		})
+	case "trailer":
+		var values []string
+		for _, trailer := range c.Trailers {
+			if strings.EqualFold(trailer.Key, p.key) {
+				values = append(values, trailer.Value)
+			}
+		}
+		if p.op == "" {
+			return len(values) > 0
+		}
+		return p.matchAny(values, p.matchValue)
+	}
+	return false
+}
+
+// matchValue is the positive form of a string operator, for use with
+// matchAny.
+func (p *predicate) matchValue(value string) bool {
+	if p.re != nil {
+		return p.re.MatchString(value)
+	}
+	return strings.EqualFold(value, p.value)
+}
+
+func (p *predicate) matchString(s string) bool {
+	switch p.op {
+	case "=":
+		return strings.EqualFold(s, p.value)
+	case "!=":
+		return !strings.EqualFold(s, p.value)
+	case "~":
+		return p.re.MatchString(s)
+	default:
+		return !p.re.MatchString(s)
+	}
+}
+
+// matchAny applies a positive operator to any of values; the negated
+// operators hold when none of the values match.
+func (p *predicate) matchAny(values []string, match func(string) bool) bool {
+	found := false
+	for _, value := range values {
+		if match(value) {
+			found = true
+			break
+		}
+	}
+	if p.op == "!=" || p.op == "!~" {
+		return !found
+	}
+	return found
+}
+
+func (p *predicate) matchDate(t time.Time) bool {
+	start, end := p.dates.Start, p.dates.End
+	instant := !end.After(start)
+
+	switch p.op {
+	case "=":
+		if instant {
+			return t.Equal(start)
+		}
+		return !t.Before(start) && t.Before(end)
+	case "!=":
+		if instant {
+			return !t.Equal(start)
+		}
+		return t.Before(start) || !t.Before(end)
+	case "<":
+		return t.Before(start)
+	case "<=":
+		if instant {
+			return !t.After(start)
+		}
+		return t.Before(end)
+	case ">":
+		if instant {
+			return t.After(start)
+		}
+		return !t.Before(end)
+	default:
+		return !t.Before(start)
+	}
+}
+
+func walk(n node, visit func(*predicate)) {
+	switch n := n.(type) {
+	case *andNode:
+		walk(n.left, visit)
+		walk(n.right, visit)
+	case *orNode:
+		walk(n.left, visit)
+		walk(n.right, visit)
+	case *notNode:
+		walk(n.inner, visit)
+	case *predicate:
+		visit(n)
+	}
+}
+
+func conjuncts(n node) []node {
+	if and, ok := n.(*andNode); ok {
+		return append(conjuncts(and.left), conjuncts(and.right)...)
+	}
+	return []node{n}
+}
@@ -0,0 +1,229 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DinethDilhara/glo/internal/gitexec"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+var commit = models.Commit{
+	Hash:           "aaaa",
+	Parents:        []string{"p1"},
+	Author:         "Jane Doe",
+	AuthorEmail:    "jane@example.com",
+	RawAuthor:      "jdoe",
+	RawAuthorEmail: "jdoe@old-laptop.local",
+	Date:           time.Date(2025, time.July, 16, 9, 0, 0, 0, time.UTC),
+	Message:        "fix: handle empty config",
+	Body:           "The loader crashed.\n\nCloses #12",
+	Trailers: []models.Trailer{
+		{Key: "Co-authored-by", Value: "Bob <bob@example.com>"},
+		{Key: "Reviewed-by", Value: "Carol <carol@example.com>"},
+	},
+	Files: []string{"cmd/config.go", "internal/config/config.go", "README.md"},
+}
+
+func TestMatch(t *testing.T) {
+	merge := commit
+	merge.Parents = []string{"p1", "p2"}
+
+	tests := []struct {
+		src    string
+		commit models.Commit
+		want   bool
+	}{
+		{"author = 'jane doe'", commit, true},
+		{"author = jdoe", commit, true},
+		{"author = jane", commit, false},
+		{"author ~ '^jane'", commit, true},
+		{"author != 'Jane Doe'", commit, false},
+		{"author !~ bob", commit, true},
+		{"email ~ 'old-laptop'", commit, true},
+		{"subject ~ '^fix:'", commit, true},
+		{"subject ~ crashed", commit, false},
+		{"body ~ crashed", commit, true},
+		{"message ~ 'config.*crashed'", commit, false},
+		{"message ~ '(?s)config.*crashed'", commit, true},
+		{"merge", commit, false},
+		{"merge", merge, true},
+		{"not merge", commit, true},
+		{"merge = false", merge, false},
+		{"merge != false", merge, true},
+		{"trailer:reviewed-by", commit, true},
+		{"trailer:signed-off-by", commit, false},
+		{"trailer:co-authored-by ~ bob", commit, true},
+		{"trailer:co-authored-by != 'Bob <bob@example.com>'", commit, false},
+		{"date = 2025-07-16", commit, true},
+		{"date = yesterday", commit, false},
+		{"date < 2025-07-16", commit, false},
+		{"date <= 2025-07-16", commit, true},
+		{"date > 2025-07-15", commit, true},
+		{"date > 2025-07-16", commit, false},
+		{"date >= today", commit, true},
+		{"date = '2025-07-16T09:00:00Z'", commit, true},
+		{"date > '2025-07-16T09:00:00Z'", commit, false},
+		{"author = jane or subject ~ fix", commit, true},
+		{"author = jane or subject ~ feat", commit, false},
+		{"not (merge or author = jane) and date = 'this week'", commit, true},
+		{"path = README.md", commit, true},
+		{"path = internal/", commit, true},
+		{"path = internal/con", commit, false},
+		{"path ~ '\\.md$'", commit, true},
+		{"path != cmd", commit, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			q, err := Parse(tt.src, now, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := q.Match(&tt.commit); got != tt.want {
+				t.Errorf("Match(%q) = %v, want %v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchBranch(t *testing.T) {
+	q, err := Parse("branch = main and branch != release", now, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reachable := map[string]map[string]bool{
+		"main":    {"aaaa": true},
+		"release": {"bbbb": true},
+	}
+	var resolved []string
+	err = q.ResolveBranches(func(ref string) (map[string]bool, error) {
+		resolved = append(resolved, ref)
+		return reachable[ref], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"main", "release"}; !reflect.DeepEqual(resolved, want) {
+		t.Errorf("resolved %v, want %v", resolved, want)
+	}
+
+	if !q.Match(&commit) {
+		t.Error("expected a commit on main only to match")
+	}
+	other := commit
+	other.Hash = "bbbb"
+	if q.Match(&other) {
+		t.Error("expected a commit on release only not to match")
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		src       string
+		opts      gitexec.LogOptions
+		author    string
+		args      []string
+		paths     []string
+		withFiles bool
+	}{
+		{src: "author = alice", author: "alice", args: []string{"--regexp-ignore-case"}},
+		{src: "author ~ alice", author: "alice", args: []string{"--regexp-ignore-case"}},
+		{src: "author ~ 'alice|bob'"},
+		{src: "author != alice"},
+		{src: "author = alice or author = bob"},
+		{src: "not author = alice"},
+		{src: "author = alice and author = bob", author: "alice", args: []string{"--regexp-ignore-case"}},
+		{src: "message ~ fix and subject = 'x'", args: []string{"--grep=fix", "--grep=x", "--regexp-ignore-case"}},
+		{src: "message ~ '^fix'"},
+		{src: "author = alice and message ~ fix", opts: gitexec.LogOptions{Author: "bob"}, author: "bob"},
+		{src: "merge", args: []string{"--merges"}},
+		{src: "merge = false", args: []string{"--no-merges"}},
+		{src: "merge != true", args: []string{"--no-merges"}},
+		{src: "not merge"},
+		{src: "date > 2025-01-01 and email = a@x"},
+		{src: "path = '*.go'", paths: []string{"*.go"}, args: []string{"--full-diff"}, withFiles: true},
+		{src: "path = cmd or path = internal", withFiles: true},
+		{src: "path ~ '\\.go$'", withFiles: true},
+		{src: "path != cmd", withFiles: true},
+		{src: "path = cmd", opts: gitexec.LogOptions{Paths: []string{"internal"}}, paths: []string{"internal"}, args: []string{"--full-diff"}, withFiles: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			q, err := Parse(tt.src, now, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			opts := tt.opts
+			q.Apply(&opts)
+
+			if opts.Author != tt.author {
+				t.Errorf("Author = %q, want %q", opts.Author, tt.author)
+			}
+			if !reflect.DeepEqual(opts.Args, tt.args) {
+				t.Errorf("Args = %q, want %q", opts.Args, tt.args)
+			}
+			if !reflect.DeepEqual(opts.Paths, tt.paths) {
+				t.Errorf("Paths = %q, want %q", opts.Paths, tt.paths)
+			}
+			if opts.WithFiles != tt.withFiles {
+				t.Errorf("WithFiles = %v, want %v", opts.WithFiles, tt.withFiles)
+			}
+		})
+	}
+}
+
+// TestPathPushdownAgreesWithMatch checks that a path predicate selects the
+// same files whether git evaluates the pushed-down pathspec or Match does,
+// from the repository root and from a subdirectory.
+func TestPathPushdownAgreesWithMatch(t *testing.T) {
+	tests := []struct {
+		value  string
+		prefix string
+		file   string
+		want   bool
+	}{
+		{"*.go", "", "main.go", true},
+		{"*.go", "", "cmd/log.go", true},
+		{"*.go", "", "README.md", false},
+		{"cmd/*.go", "", "cmd/sub/x.go", true},
+		{"cmd/?og.go", "", "cmd/log.go", true},
+		{"cmd", "", "cmd/log.go", true},
+		{"cmd", "", "cmdline/x.go", false},
+		{"log.go", "cmd/", "cmd/log.go", true},
+		{"log.go", "cmd/", "log.go", false},
+		{"*.go", "cmd/", "cmd/log.go", true},
+		{"*.go", "cmd/", "main.go", false},
+		{"../README.md", "cmd/", "README.md", true},
+		{":/README.md", "cmd/", "README.md", true},
+		{".", "cmd/", "cmd/log.go", true},
+		{".", "cmd/", "main.go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix+tt.value+" "+tt.file, func(t *testing.T) {
+			q, err := Parse("path = '"+tt.value+"'", now, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			q.SetPathPrefix(tt.prefix)
+
+			var opts gitexec.LogOptions
+			q.Apply(&opts)
+			if len(opts.Paths) != 1 {
+				t.Fatalf("pushed paths %q, want one", opts.Paths)
+			}
+			pushed := gitexec.MatchPathspec(gitexec.ResolvePathspec(opts.Paths[0], tt.prefix), tt.file)
+
+			c := models.Commit{Files: []string{tt.file}}
+			matched := q.Match(&c)
+
+			if pushed != tt.want || matched != tt.want {
+				t.Errorf("file %s: pushed pathspec matches = %v, Match = %v, want %v", tt.file, pushed, matched, tt.want)
+			}
+		})
+	}
+}
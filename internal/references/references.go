@@ -0,0 +1,169 @@
+package references
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/config"
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+// DefaultPullRequestPatterns find squash-merge subjects such as
+// "Fix login (#1234)", GitHub merge commits ("Merge pull request #1234
+// from ...") and GitLab merge commits ("See merge request group/app!1234").
+var DefaultPullRequestPatterns = []string{
+	`\(#(\d+)\)`,
+	`^Merge pull request #(\d+)`,
+	`See merge request [\w./-]*!(\d+)`,
+}
+
+// DefaultIssuePatterns find Jira-style issue keys such as PROJ-123.
+var DefaultIssuePatterns = []string{
+	`\b([A-Z][A-Z0-9]+-\d+)\b`,
+}
+
+// notIssueProjects are prefixes the default issue pattern would otherwise
+// take for issue keys, as in UTF-8 or SHA-256.
+var notIssueProjects = map[string]bool{
+	"CVE": true, "ISO": true, "RFC": true, "SHA": true, "UTF": true,
+}
+
+// Extractor finds pull request and issue references in commit messages.
+type Extractor struct {
+	pullRequests   []*regexp.Regexp
+	issues         []*regexp.Regexp
+	pullRequestURL string
+	issueURL       string
+	// defaultIssues is set when the built-in issue patterns are used.
+	defaultIssues bool
+}
+
+// NewExtractor compiles the configured patterns, falling back to the
+// defaults for any kind that has none.
+func NewExtractor(cfg config.References) (*Extractor, error) {
+	pullRequestPatterns := cfg.PullRequestPatterns
+	if len(pullRequestPatterns) == 0 {
+		pullRequestPatterns = DefaultPullRequestPatterns
+	}
+	issuePatterns := cfg.IssuePatterns
+	if len(issuePatterns) == 0 {
+		issuePatterns = DefaultIssuePatterns
+	}
+
+	pullRequests, err := compile(pullRequestPatterns, "pull request")
+	if err != nil {
+		return nil, err
+	}
+	issues, err := compile(issuePatterns, "issue")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Extractor{
+		pullRequests:   pullRequests,
+		issues:         issues,
+		pullRequestURL: cfg.PullRequestURL,
+		issueURL:       cfg.IssueURL,
+		defaultIssues:  len(cfg.IssuePatterns) == 0,
+	}, nil
+}
+
+func compile(patterns []string, kind string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern '%s': %v", kind, pattern, err)
+		}
+		compiled[i] = re
+	}
+	return compiled, nil
+}
+
+// Extract returns the pull requests and then the issues referenced in text,
+// each once, in the order they are found.
+func (e *Extractor) Extract(text string) []models.Reference {
+	var references []models.Reference
+	seen := make(map[models.Reference]bool)
+
+	add := func(patterns []*regexp.Regexp, kind, template string) {
+		for _, re := range patterns {
+			for _, match := range re.FindAllStringSubmatch(text, -1) {
+				id := match[0]
+				if len(match) > 1 && match[1] != "" {
+					id = match[1]
+				}
+				if kind == models.ReferenceIssue && e.defaultIssues {
+					project, _, _ := strings.Cut(id, "-")
+					if notIssueProjects[project] {
+						continue
+					}
+				}
+				reference := models.Reference{Type: kind, ID: id, URL: expand(template, id)}
+				if !seen[reference] {
+					seen[reference] = true
+					references = append(references, reference)
+				}
+			}
+		}
+	}
+	add(e.pullRequests, models.ReferencePullRequest, e.pullRequestURL)
+	add(e.issues, models.ReferenceIssue, e.issueURL)
+
+	return references
+}
+
+// Issues returns the issues referenced in text, such as a branch name.
+func (e *Extractor) Issues(text string) []models.Reference {
+	var issues []models.Reference
+	for _, reference := range e.Extract(text) {
+		if reference.Type == models.ReferenceIssue {
+			issues = append(issues, reference)
+		}
+	}
+	return issues
+}
+
+// ApplyCommits sets the references found in the subject and body of each
+// commit.
+func (e *Extractor) ApplyCommits(commits []models.Commit) {
+	for i := range commits {
+		commits[i].References = e.Extract(commits[i].FullMessage())
+	}
+}
+
+func expand(template, id string) string {
+	if template == "" {
+		return ""
+	}
+	return strings.ReplaceAll(template, "{id}", id)
+}
+
+var mergedBranchPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^Merge (?:remote-tracking )?branch '([^']+)'`),
+	regexp.MustCompile(`^Merge pull request #\d+ from (\S+)`),
+}
+
+// MergedBranch returns the name of the branch a merge commit merged, read
+// from the subject git, GitHub and GitLab write, or "" when the subject
+// names none.
+func MergedBranch(subject string) string {
+	for _, re := range mergedBranchPatterns {
+		if match := re.FindStringSubmatch(subject); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
+
+// Append adds the references not yet in list.
+func Append(list []models.Reference, more ...models.Reference) []models.Reference {
+	for _, reference := range more {
+		if !slices.Contains(list, reference) {
+			list = append(list, reference)
+		}
+	}
+	return list
+}
@@ -0,0 +1,98 @@
+package trailers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/DinethDilhara/glo/internal/models"
+)
+
+type usage struct {
+	key         string
+	commits     int
+	occurrences int
+	values      *models.AuthorTally
+}
+
+// Build reports how often each trailer key appears across commits and its
+// most common values, at most top per key (0 = all). Keys are grouped
+// case-insensitively and shown with their first spelling; identity values
+// are grouped by identity like authors are. Only the given keys are
+// reported when keys is not empty.
+func Build(commits []models.Commit, keys []string, top int) *models.TrailerReport {
+	report := &models.TrailerReport{TotalCommits: len(commits)}
+	usages := make(map[string]*usage)
+
+	for _, commit := range commits {
+		seen := make(map[string]bool)
+		for _, trailer := range commit.Trailers {
+			if len(keys) > 0 && !containsFold(keys, trailer.Key) {
+				continue
+			}
+
+			id := strings.ToLower(trailer.Key)
+			u, ok := usages[id]
+			if !ok {
+				u = &usage{key: trailer.Key, values: models.NewAuthorTally()}
+				usages[id] = u
+			}
+
+			u.occurrences++
+			if !seen[id] {
+				seen[id] = true
+				u.commits++
+			}
+
+			if trailer.IsIdentity() {
+				u.values.Add(trailer.Name, trailer.Email, 1)
+			} else {
+				u.values.Add(trailer.Value, "", 1)
+			}
+		}
+		if len(seen) > 0 {
+			report.CommitsWithTrailers++
+		}
+	}
+
+	for _, u := range usages {
+		ranked := u.values.Ranked()
+		entry := models.TrailerUsage{
+			Key:            u.key,
+			Commits:        u.commits,
+			Occurrences:    u.occurrences,
+			DistinctValues: len(ranked),
+		}
+		if report.TotalCommits > 0 {
+			entry.Percent = float64(u.commits) * 100 / float64(report.TotalCommits)
+		}
+		if top > 0 && len(ranked) > top {
+			ranked = ranked[:top]
+		}
+		for _, value := range ranked {
+			entry.Values = append(entry.Values, models.TrailerValueCount{
+				Value: value.Author,
+				Email: value.Email,
+				Count: value.Count,
+			})
+		}
+		report.Keys = append(report.Keys, entry)
+	}
+
+	sort.Slice(report.Keys, func(i, j int) bool {
+		if report.Keys[i].Commits != report.Keys[j].Commits {
+			return report.Keys[i].Commits > report.Keys[j].Commits
+		}
+		return strings.ToLower(report.Keys[i].Key) < strings.ToLower(report.Keys[j].Key)
+	})
+
+	return report
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}